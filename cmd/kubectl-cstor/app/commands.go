@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"os"
+
+	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	"github.com/openebs/cstor-operators/pkg/cstorctl"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// CmdOptions has the flags common to all the commands
+type CmdOptions struct {
+	kubeconfig string
+	context    string
+	namespace  string
+	dryRun     bool
+}
+
+// NewCmdCStor creates the kubectl cstor command along with its sub commands
+func NewCmdCStor() *cobra.Command {
+	options := &CmdOptions{}
+	cmd := &cobra.Command{
+		Use:   "kubectl-cstor",
+		Short: "Day-2 operations of cStor pools and volumes",
+		Long: `Performs day-2 operations of cStor pools and volumes by computing the
+changes of the CStorPoolCluster or CStorVolumeConfig. Changes are previewed as
+a diff and judged by the cStor admission webhook before they are applied.`,
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringVar(&options.kubeconfig, "kubeconfig", "",
+		"path to the kubeconfig file, defaults to $KUBECONFIG or ~/.kube/config")
+	cmd.PersistentFlags().StringVar(&options.context, "context", "",
+		"name of the kubeconfig context to use")
+	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", cstorctl.DefaultNamespace,
+		"namespace where OpenEBS is installed")
+
+	cmd.AddCommand(
+		NewCmdDescribe(options),
+		NewCmdReplaceDisk(options),
+		NewCmdExpandPool(options),
+		NewCmdScaleReplicas(options),
+		NewCmdMigrateReplica(options),
+	)
+	return cmd
+}
+
+// addDryRunFlag adds the dry run flag to the commands changing resources
+func addDryRunFlag(cmd *cobra.Command, options *CmdOptions) {
+	cmd.Flags().BoolVar(&options.dryRun, "dry-run", false,
+		"only preview the changes and have them validated by the admission webhook")
+}
+
+// newClient returns the client of cStor operations built from the options
+func (o *CmdOptions) newClient() (*cstorctl.Client, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = o.kubeconfig
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: o.context}).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "error building kubeconfig")
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building kubernetes clientset")
+	}
+	openebsClient, err := clientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building openebs clientset")
+	}
+	return cstorctl.NewClient(kubeClient, openebsClient, o.namespace).
+		WithOutput(os.Stdout).
+		WithDryRun(o.dryRun), nil
+}
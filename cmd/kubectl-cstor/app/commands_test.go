@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestNewCmdCStor is to test kubectl cstor command.
+func TestNewCmdCStor(t *testing.T) {
+	expected := []string{"describe", "expand-pool", "migrate-replica", "replace-disk", "scale-replicas"}
+	cmds := NewCmdCStor().Commands()
+	if len(cmds) != len(expected) {
+		t.Fatalf("ExpectedCommands: %d ActualCommands: '%d'", len(expected), len(cmds))
+	}
+	for i, name := range expected {
+		if cmds[i].Name() != name {
+			t.Errorf("ExpectedCommand: '%s' ActualCommand: '%s'", name, cmds[i].Name())
+		}
+	}
+}
+
+func TestParseRaidGroups(t *testing.T) {
+	got, err := parseRaidGroups([]string{"bd-1, bd-2", "bd-3,bd-4,"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := [][]string{{"bd-1", "bd-2"}, {"bd-3", "bd-4"}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected raid groups %v but got %v", expected, got)
+	}
+	for _, raidGroups := range [][]string{nil, {" , "}} {
+		if _, err := parseRaidGroups(raidGroups); err == nil {
+			t.Errorf("expected error parsing raid groups %q", raidGroups)
+		}
+	}
+}
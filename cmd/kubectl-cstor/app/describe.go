@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCmdDescribe describes cStor pools and volumes
+func NewCmdDescribe(options *CmdOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describe cStor pools and volumes",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "pool <cspc|cspi>",
+			Short: "Describe the pool cluster and its pool instances",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := options.newClient()
+				if err != nil {
+					return err
+				}
+				return client.DescribePool(args[0])
+			},
+		},
+		&cobra.Command{
+			Use:   "volume <pv>",
+			Short: "Describe the volume along with its target and replicas",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := options.newClient()
+				if err != nil {
+					return err
+				}
+				return client.DescribeVolume(args[0])
+			},
+		},
+	)
+	return cmd
+}
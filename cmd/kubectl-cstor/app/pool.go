@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdReplaceDisk replaces a blockdevice of a pool
+func NewCmdReplaceDisk(options *CmdOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-disk <cspc> <old-blockdevice> <new-blockdevice>",
+		Short: "Replace a blockdevice of a mirror or raidz pool",
+		Long: `Replaces the blockdevice in the raid group of the pool cluster with
+a new blockdevice on the same node. Only one blockdevice of a raid group can be
+replaced at a time, the pool resilvers the data on to the new blockdevice.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			return client.ReplaceDisk(args[0], args[1], args[2])
+		},
+	}
+	addDryRunFlag(cmd, options)
+	return cmd
+}
+
+// NewCmdExpandPool adds blockdevices to a pool
+func NewCmdExpandPool(options *CmdOptions) *cobra.Command {
+	var raidGroups []string
+	var writeCache bool
+	cmd := &cobra.Command{
+		Use:   "expand-pool <cspi> --raid-group <blockdevice>[,<blockdevice>...]",
+		Short: "Expand a pool with new blockdevices",
+		Long: `Adds the blockdevices to the raid group of a stripe pool or adds every
+--raid-group as a new raid group of a mirror or raidz pool.`,
+		Example: `  # add a mirror raid group to the pool
+  kubectl cstor expand-pool cspc-mirror-x7k2 --raid-group blockdevice-1,blockdevice-2`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bdGroups, err := parseRaidGroups(raidGroups)
+			if err != nil {
+				return err
+			}
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			return client.ExpandPool(args[0], bdGroups, writeCache)
+		},
+	}
+	cmd.Flags().StringArrayVar(&raidGroups, "raid-group", nil,
+		"comma separated blockdevices of a raid group, can be repeated")
+	cmd.Flags().BoolVar(&writeCache, "write-cache", false,
+		"expand the write cache raid groups instead of the data raid groups")
+	addDryRunFlag(cmd, options)
+	return cmd
+}
+
+// parseRaidGroups returns the blockdevices of every raid group flag
+func parseRaidGroups(raidGroups []string) ([][]string, error) {
+	var bdGroups [][]string
+	for _, rg := range raidGroups {
+		var bds []string
+		for _, bd := range strings.Split(rg, ",") {
+			if bd = strings.TrimSpace(bd); bd != "" {
+				bds = append(bds, bd)
+			}
+		}
+		if len(bds) == 0 {
+			return nil, errors.Errorf("raid group %q has no blockdevices", rg)
+		}
+		bdGroups = append(bdGroups, bds)
+	}
+	if len(bdGroups) == 0 {
+		return nil, errors.New("at least one --raid-group is required")
+	}
+	return bdGroups, nil
+}
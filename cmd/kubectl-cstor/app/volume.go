@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdScaleReplicas adds or removes replicas of a volume
+func NewCmdScaleReplicas(options *CmdOptions) *cobra.Command {
+	var addPools, removePools []string
+	cmd := &cobra.Command{
+		Use:   "scale-replicas <pv> [--add <cspi>,...] [--remove <cspi>]",
+		Short: "Add or remove replicas of a volume",
+		Long: `Adds replicas of the volume on the given pools or removes the replica
+of the volume from the given pool. Only one replica can be removed at a time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			return client.ScaleReplicas(args[0], addPools, removePools)
+		},
+	}
+	cmd.Flags().StringSliceVar(&addPools, "add", nil, "pools to add replicas on")
+	cmd.Flags().StringSliceVar(&removePools, "remove", nil, "pool to remove the replica from")
+	addDryRunFlag(cmd, options)
+	return cmd
+}
+
+// NewCmdMigrateReplica moves a replica of a volume to another pool
+func NewCmdMigrateReplica(options *CmdOptions) *cobra.Command {
+	var fromPool, toPool string
+	var timeout time.Duration
+	cmd := &cobra.Command{
+		Use:   "migrate-replica <pv> --from <cspi> --to <cspi>",
+		Short: "Move a replica of a volume to another pool",
+		Long: `Requests the CVC operator to migrate the replica of the volume. The
+operator adds a replica of the volume on the new pool, waits for it to be
+rebuilt and removes the replica from the old pool. The command waits for the
+migration to finish within the timeout, migration continues in the background
+once the timeout expires.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromPool == "" || toPool == "" {
+				return errors.New("both --from and --to pools are required")
+			}
+			client, err := options.newClient()
+			if err != nil {
+				return err
+			}
+			return client.MigrateReplica(args[0], fromPool, toPool, timeout)
+		},
+	}
+	cmd.Flags().StringVar(&fromPool, "from", "", "pool to move the replica from")
+	cmd.Flags().StringVar(&toPool, "to", "", "pool to move the replica to")
+	cmd.Flags().DurationVar(&timeout, "timeout", time.Hour,
+		"time to wait for the migration to finish, 0 doesn't wait")
+	addDryRunFlag(cmd, options)
+	return cmd
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	"flag"
 	"os"
 	"strconv"
@@ -28,7 +29,10 @@ import (
 	cspicontroller "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller"
 	replicacontroller "github.com/openebs/cstor-operators/pkg/controllers/replica-controller"
 	restorecontroller "github.com/openebs/cstor-operators/pkg/controllers/restore-controller"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
 	"github.com/openebs/cstor-operators/pkg/pool"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 
@@ -43,6 +47,7 @@ import (
 
 var (
 	kubeconfig = flag.String("kubeconfig", "", "Path for kube config")
+	healthPort = flag.Int("health-port", health.DefaultPort, "Port serving the liveness and readiness endpoints, 0 disables it")
 )
 
 const (
@@ -63,6 +68,19 @@ func Start() error {
 	}
 	flag.Parse()
 
+	// health endpoints are served before waiting on zrepl and the pool so
+	// that a manager waiting on the pool container isn't restarted
+	if *healthPort != 0 {
+		health.AddReadinessCheck("zrepl", pool.CheckZrepl)
+		health.StartServer(*healthPort, stopCh)
+	}
+
+	shutdownTracing, err := tracing.Init("cstor-pool-manager")
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize tracing")
+	}
+	defer shutdownTracing(context.TODO())
+
 	cfg, err := getClusterConfig(*kubeconfig)
 	if err != nil {
 		return errors.Wrap(err, "error building kubeconfig")
@@ -74,6 +92,7 @@ func Start() error {
 		return errors.Wrap(err, "error building kubernetes clientset")
 	}
 	common.Init()
+	alertlog.ForwardToEvents(kubeClient, "cstor-pool-manager")
 	// Building OpenEBS Clientset
 	openebsClient, err := clientset.NewForConfig(cfg)
 	if err != nil {
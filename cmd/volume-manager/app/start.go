@@ -17,20 +17,26 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	goflag "flag"
 	"sync"
 
 	mgmtcontroller "github.com/openebs/cstor-operators/pkg/controllers/volume-mgmt"
 	"github.com/openebs/cstor-operators/pkg/controllers/volume-mgmt/volume"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	serverclient "github.com/openebs/cstor-operators/pkg/volume-rpc/client"
 	targetserver "github.com/openebs/cstor-operators/pkg/volume-rpc/targetserver"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
 )
 
 // CmdStartOptions has flags for starting CStorVolume watcher.
 type CmdStartOptions struct {
 	kubeconfig string
 	port       string
+	healthPort int
 }
 
 // NewCmdStart starts gRPC server and watcher for CStorVolume.
@@ -42,6 +48,16 @@ func NewCmdStart() *cobra.Command {
 		Long: `The grpc server would be serving snapshot requests whereas
 		the watcher would be watching for add, updat, delete events`,
 		Run: func(cmd *cobra.Command, args []string) {
+			// health endpoints are served before waiting on istgt so that a
+			// manager waiting on the target container isn't restarted
+			if options.healthPort != 0 {
+				health.StartServer(options.healthPort, wait.NeverStop)
+			}
+			shutdownTracing, err := tracing.Init("cstor-volume-manager")
+			if err != nil {
+				klog.Fatalf("Failed to initialize tracing: %v", err)
+			}
+			defer shutdownTracing(context.TODO())
 			var wg sync.WaitGroup
 			wg.Add(1)
 			go func() {
@@ -66,6 +82,8 @@ func NewCmdStart() *cobra.Command {
 		`kubeconfig needs to be specified if out of cluster`)
 	cmd.Flags().StringVarP(&options.port, "port", "p", options.port,
 		"port on which the server should listen on")
+	cmd.Flags().IntVar(&options.healthPort, "health-port", health.DefaultPort,
+		"port serving the liveness and readiness endpoints, 0 disables it")
 
 	return cmd
 }
@@ -24,6 +24,8 @@ import (
 	"syscall"
 
 	webhook "github.com/openebs/cstor-operators/pkg/webhook"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -37,11 +39,18 @@ var (
 	kubeconfig string
 )
 
+// Command line flags
+var (
+	leaderElection = flag.Bool("leader-election", true, "Enables leader election of the replica managing "+
+		"the webhook configuration, required to run multiple webhook replicas.")
+)
+
 func main() {
 	var parameters webhook.Parameters
 
 	// get command line parameters
 	flag.IntVar(&parameters.Port, "port", 8443, "Webhook server port.")
+	flag.IntVar(&parameters.MetricsPort, "metricsPort", 9500, "Port serving the webhook metrics, 0 disables it.")
 	flag.StringVar(&parameters.CertFile, "tlsCertFile", "/etc/webhook/certs/cert.pem", "File containing the x509 Certificate for HTTPS.")
 	flag.StringVar(&parameters.KeyFile, "tlsKeyFile", "/etc/webhook/certs/key.pem", "File containing the x509 private key to --tlsCertFile.")
 
@@ -68,31 +77,60 @@ func main() {
 		klog.Fatalf("Error building openebs clientset: %s", err.Error())
 	}
 
-	// Building Snapshot Clientset
-	// snapClient, err := snapclientset.NewForConfig(cfg)
-	// if err != nil {
-	// 	klog.Fatalf("Error building openebs snapshot clientset: %s", err.Error())
-	// }
+	// Building Dynamic Client for CSI snapshot resources
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("Error building dynamic client: %s", err.Error())
+	}
 
 	// Fetch a reference to the admission server deployment object
 	ownerReference, err := webhook.GetAdmissionReference(kubeClient)
 	if err != nil {
 		klog.Fatal(err, "failed to get a reference of the admission deployment object")
 	}
-	validatorErr := webhook.InitValidationServer(*ownerReference, kubeClient)
-	if validatorErr != nil {
-		klog.Fatal(validatorErr, "failed to initialize validation server")
+
+	stopCh := make(chan struct{})
+	var configManager *webhook.ConfigManager
+	if *leaderElection {
+		// all the replicas serve the admission requests, the webhook
+		// configuration is managed by the leader
+		configManager, err = webhook.NewConfigManager(*ownerReference, kubeClient)
+		if err != nil {
+			klog.Fatalf("failed to create webhook config manager: %s", err.Error())
+		}
+		go configManager.Run(stopCh)
+	} else {
+		validatorErr := webhook.InitValidationServer(*ownerReference, kubeClient)
+		if validatorErr != nil {
+			klog.Fatal(validatorErr, "failed to initialize validation server")
+		}
 	}
 
-	wh, err := webhook.New(parameters, kubeClient, openebsClient)
+	wh, err := webhook.New(parameters, kubeClient, openebsClient, dynamicClient)
 	if err != nil {
 		klog.Fatalf("failed to create validation webhook: %s", err.Error())
 	}
+	if configManager != nil {
+		wh.WithConfigManager(configManager)
+	}
 	// define http server and server handler
 	mux := http.NewServeMux()
 	mux.HandleFunc("/validate", wh.Serve)
+	mux.HandleFunc("/mutate", wh.Serve)
 	wh.Server.Handler = mux
 
+	// serve the metrics over plain http so that they can be scraped
+	// without the webhook certificate
+	if parameters.MetricsPort != 0 {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		go func() {
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", parameters.MetricsPort), metricsMux); err != nil {
+				klog.Errorf("Failed to serve webhook metrics: %v", err)
+			}
+		}()
+	}
+
 	// start webhook server in new routine
 	go func() {
 		if err := wh.Server.ListenAndServeTLS("", ""); err != nil {
@@ -100,12 +138,16 @@ func main() {
 		}
 	}()
 
+	// rotate and reload the serving certificate in background
+	go wh.RunCertWatcher(stopCh)
+
 	klog.Info("Webhook server started")
 
 	// listening OS shutdown singal
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, syscall.SIGINT, syscall.SIGKILL, syscall.SIGTERM)
 	<-signalChan
+	close(stopCh)
 
 	klog.Infof("Got OS shutdown signal, shutting down webhook server gracefully...")
 	err = wh.Server.Shutdown(context.Background())
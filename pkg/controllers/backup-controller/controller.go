@@ -33,6 +33,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 
 	cstorapis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	openebstypes "github.com/openebs/api/v3/pkg/apis/types"
@@ -151,6 +152,7 @@ func NewCStorBackupController(
 			klog.Infof("CStorBackup Resource delete event: %v, %v", bkp.ObjectMeta.Name, string(bkp.ObjectMeta.UID))
 		},
 	})
+	health.RegisterController(backupControllerName)
 	return controller
 }
 
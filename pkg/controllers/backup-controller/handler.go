@@ -22,7 +22,10 @@ import (
 
 	cstorapis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/volumereplica"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
@@ -110,7 +113,15 @@ func (c *BackupController) syncEventHandler(bkp *cstorapis.CStorBackup) (string,
 			return "", err
 		}
 
+		// continues the backup trace of the CVC server
+		_, span := tracing.Start(context.TODO(), bkp, "CreateVolumeBackup",
+			attribute.String("volume", bkp.Spec.VolumeName),
+			attribute.String("snapshot", bkp.Spec.SnapName))
+		// sending the snapshot can outlast the worker timeout of liveness
+		doneOp := health.TrackLongOperation(backupControllerName)
 		err = volumereplica.CreateVolumeBackup(bkp)
+		doneOp()
+		tracing.End(span, err)
 		if err != nil {
 			c.recorder.Eventf(bkp, corev1.EventTypeWarning, "Backup", "failed to create backup error: %s", err.Error())
 			return string(cstorapis.BKPCStorStatusFailed), err
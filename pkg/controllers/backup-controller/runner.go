@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -42,6 +43,7 @@ func (c *BackupController) Run(threadiness int, stopCh <-chan struct{}) error {
 	if ok := cache.WaitForCacheSync(stopCh, c.BackupSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
+	health.MarkSynced(backupControllerName)
 
 	klog.V(1).Info("Starting CStorBackup workers")
 
@@ -73,6 +75,7 @@ func (c *BackupController) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	defer health.TrackWork(backupControllerName)()
 
 	// We wrap this block in a func so we can defer c.workqueue.Done.
 	err := func(obj interface{}) error {
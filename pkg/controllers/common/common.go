@@ -145,6 +145,9 @@ const (
 	// OpenEBSIOPoolName is cstorpoolcluster name as environment variable
 	// specified in pod instance pods.
 	OpenEBSIOPoolName Environment = "OPENEBS_IO_POOL_NAME"
+	// OpenEBSIOPodIP is the IP address of the pool instance pod as
+	// environment variable specified in pool instance pods.
+	OpenEBSIOPodIP Environment = "POD_IP"
 )
 
 // QueueOperation determines the type of operation
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cspccontroller
+
+import (
+	"context"
+	"fmt"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// PoolDraining is the reason of PoolDrained condition while volume
+	// replicas are left on the pool
+	PoolDraining = "Draining"
+	// PoolDrained is the reason of PoolDrained condition once no volume
+	// replica is left on the pool
+	PoolDrained = "Drained"
+)
+
+// syncDrainStatus reports the progress of draining the pools of CSPC in the
+// PoolDrained condition of CSPI. Replicas on the pool are migrated by the CVC
+// operator, the condition turns True once no replica is left on the pool and
+// is removed once the drain annotation is removed from CSPI.
+func (pc *PoolConfig) syncDrainStatus(cspiList *cstor.CStorPoolInstanceList) {
+	for i := range cspiList.Items {
+		cspi := cspiList.Items[i].DeepCopy()
+		if err := pc.syncCSPIDrainStatus(cspi); err != nil {
+			klog.Errorf("could not sync drain status of cspi %s: %s", cspi.Name, err.Error())
+		}
+	}
+}
+
+// syncCSPIDrainStatus updates the PoolDrained condition of CSPI as per the
+// volume replicas left on the pool
+func (pc *PoolConfig) syncCSPIDrainStatus(cspi *cstor.CStorPoolInstance) error {
+	current := cspiutil.GetCSPICondition(cspi.Status, cspiutil.CSPIPoolDrained)
+	if !cspiutil.IsDrainRequested(cspi) {
+		if current == nil {
+			return nil
+		}
+		cspiutil.RemoveCSPICondition(&cspi.Status, cspiutil.CSPIPoolDrained)
+		return pc.updateCSPI(cspi)
+	}
+
+	cvrList, err := pc.Controller.clientset.CstorV1().CStorVolumeReplicas(cspi.Namespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: types.CStorPoolInstanceNameLabelKey + "=" + cspi.Name,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cvr of cspi %s", cspi.Name)
+	}
+	condition := cspiutil.NewCSPICondition(cspiutil.CSPIPoolDrained, corev1.ConditionFalse, PoolDraining,
+		fmt.Sprintf("%d volume replica(s) left on the pool", len(cvrList.Items)))
+	if len(cvrList.Items) == 0 {
+		condition = cspiutil.NewCSPICondition(cspiutil.CSPIPoolDrained, corev1.ConditionTrue, PoolDrained,
+			"No volume replica is left on the pool, pool can be removed from the cstorpoolcluster")
+	}
+	if current != nil && current.Status == condition.Status && current.Message == condition.Message {
+		return nil
+	}
+	cspiutil.SetCSPICondition(&cspi.Status, *condition)
+	if err := pc.updateCSPI(cspi); err != nil {
+		return err
+	}
+	if condition.Status == corev1.ConditionTrue {
+		pc.Controller.recorder.Eventf(pc.AlgorithmConfig.CSPC, corev1.EventTypeNormal, PoolDrained,
+			"Pool %s is drained and can be removed from the cstorpoolcluster", cspi.Name)
+	}
+	return nil
+}
+
+func (pc *PoolConfig) updateCSPI(cspi *cstor.CStorPoolInstance) error {
+	_, err := pc.Controller.clientset.CstorV1().CStorPoolInstances(cspi.Namespace).
+		Update(context.TODO(), cspi, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to update cspi %s", cspi.Name)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cspccontroller
+
+import (
+	"context"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	openebsFakeClientset "github.com/openebs/api/v3/pkg/client/clientset/versioned/fake"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	"github.com/openebs/cstor-operators/pkg/cspc/algorithm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestSyncDrainStatus(t *testing.T) {
+	cspc := &cstor.CStorPoolCluster{}
+	cspc.Name = "cspc-foo"
+	cspc.Namespace = "openebs"
+	cspi := newCSPI("cspi-foo", map[string]string{types.CStorPoolClusterLabelKey: cspc.Name}, nil, false)
+	cspi.Namespace = cspc.Namespace
+	cspi.Annotations = map[string]string{cspiutil.DrainAnnotation: "true"}
+	cvr := &cstor.CStorVolumeReplica{}
+	cvr.Name = "pvc-foo-cspi-foo"
+	cvr.Namespace = cspc.Namespace
+	cvr.Labels = map[string]string{types.CStorPoolInstanceNameLabelKey: cspi.Name}
+
+	clientset := openebsFakeClientset.NewSimpleClientset(cspi, cvr)
+	pc := &PoolConfig{
+		AlgorithmConfig: &algorithm.Config{CSPC: cspc, Namespace: cspc.Namespace},
+		Controller:      &Controller{clientset: clientset, recorder: record.NewFakeRecorder(10)},
+	}
+	cspis := clientset.CstorV1().CStorPoolInstances(cspc.Namespace)
+	expectCondition := func(status corev1.ConditionStatus, reason string) {
+		t.Helper()
+		cspiList, err := pc.Controller.GetCSPIListForCSPC(cspc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pc.syncDrainStatus(cspiList)
+		got, err := cspis.Get(context.TODO(), cspi.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cond := cspiutil.GetCSPICondition(got.Status, cspiutil.CSPIPoolDrained)
+		switch {
+		case reason == "" && cond != nil:
+			t.Errorf("expected no drained condition but got %+v", cond)
+		case reason != "" && (cond == nil || cond.Status != status || cond.Reason != reason):
+			t.Errorf("expected drained condition %s/%s but got %+v", status, reason, cond)
+		}
+	}
+
+	expectCondition(corev1.ConditionFalse, PoolDraining)
+
+	_ = clientset.CstorV1().CStorVolumeReplicas(cspc.Namespace).Delete(context.TODO(), cvr.Name, metav1.DeleteOptions{})
+	expectCondition(corev1.ConditionTrue, PoolDrained)
+
+	// removing the drain annotation removes the condition
+	got, _ := cspis.Get(context.TODO(), cspi.Name, metav1.GetOptions{})
+	got.Annotations = nil
+	_, _ = cspis.Update(context.TODO(), got, metav1.UpdateOptions{})
+	expectCondition("", "")
+}
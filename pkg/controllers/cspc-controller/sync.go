@@ -28,6 +28,7 @@ import (
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	"github.com/openebs/api/v3/pkg/util"
 	"github.com/openebs/cstor-operators/pkg/cspc/algorithm"
+	"github.com/openebs/cstor-operators/pkg/util/defaults"
 	"github.com/openebs/cstor-operators/pkg/version"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/apps/v1"
@@ -49,9 +50,6 @@ type upgradeFunc func(u *upgradeParams) (*cstor.CStorPoolCluster, error)
 
 var (
 	upgradeMap = map[string]upgradeFunc{}
-	// defaultROThresholdLimit is the default
-	// value in form of percentage for ROThreshold limit
-	defaultROThresholdLimit = 85
 )
 
 func (c *Controller) sync(cspc *cstor.CStorPoolCluster, cspiList *cstor.CStorPoolInstanceList) error {
@@ -180,6 +178,10 @@ func (c *Controller) sync(cspc *cstor.CStorPoolCluster, cspiList *cstor.CStorPoo
 
 	pc.handleOperations()
 
+	// Update of cspi(s) which were modified above fails with a conflict and is
+	// retried on the next sync.
+	pc.syncDrainStatus(cspiList)
+
 	err = c.UpdateStatusEventually(cspcGot)
 	if err != nil {
 		message := fmt.Sprintf("Error in updating status:{%s}", err.Error())
@@ -507,7 +509,9 @@ is taken.
 Please refer following design document to understand more
 https://github.com/openebs/api/tree/HEAD/design/cstor/v1
 
-ToDo: Offload this defaulting mechanism to mutating webhook server.
+The mutating webhook defaults the fields which don't depend on the cspc
+level defaults when the cspc is admitted, this takes care of the cspc
+admitted while the webhook was unavailable.
 */
 func defaultPoolConfig(cspi *cstor.CStorPoolInstance, cspc *cstor.CStorPoolCluster) {
 	if cspi.Spec.PoolConfig.Resources == nil {
@@ -525,9 +529,7 @@ func defaultPoolConfig(cspi *cstor.CStorPoolInstance, cspc *cstor.CStorPoolClust
 		cspi.Spec.PoolConfig.PriorityClassName = &priorityClassName
 	}
 
-	if cspi.Spec.PoolConfig.ROThresholdLimit == nil {
-		cspi.Spec.PoolConfig.ROThresholdLimit = &defaultROThresholdLimit
-	}
+	defaults.SetPoolConfigDefaults(&cspi.Spec.PoolConfig)
 }
 
 /*
@@ -30,6 +30,7 @@ import (
 
 	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	common "github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 	zcmd "github.com/openebs/cstor-operators/pkg/zcmd/bin"
 
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
@@ -138,6 +139,7 @@ func NewCStorPoolInstanceController(
 		},
 	})
 
+	health.RegisterController(poolControllerName)
 	return controller
 }
 
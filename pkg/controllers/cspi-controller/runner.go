@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -42,6 +43,7 @@ func (c *CStorPoolInstanceController) Run(threadiness int, stopCh <-chan struct{
 	if ok := cache.WaitForCacheSync(stopCh, c.cStorPoolInstanceSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
+	health.MarkSynced(poolControllerName)
 	klog.Info("Starting CStorPoolInstance workers")
 	// Launch worker to process CStorPoolInstance resources
 	for i := 0; i < threadiness; i++ {
@@ -82,6 +84,7 @@ func (c *CStorPoolInstanceController) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	defer health.TrackWork(poolControllerName)()
 
 	// We wrap this block in a func so we can defer c.workqueue.Done.
 	err := func(obj interface{}) error {
@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cspicontroller
+
+import (
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+)
+
+const (
+	// CordonAnnotation is the annotation on CSPI to stop placing new volume
+	// replicas on the pool. Existing replicas on the pool keep serving.
+	CordonAnnotation = "cstorpoolinstance.openebs.io/cordon"
+)
+
+// IsCordoned returns true if the CSPI is cordoned from new replica placement
+func IsCordoned(cspi *cstor.CStorPoolInstance) bool {
+	return cspi.GetAnnotations()[CordonAnnotation] == "true"
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cspicontroller
+
+import (
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+)
+
+const (
+	// DrainAnnotation is the annotation on CSPI to request migration of all
+	// the volume replicas on the pool to other pools of the CSPC so that the
+	// pool can be removed from the CSPC
+	DrainAnnotation = "cstorpoolinstance.openebs.io/drain"
+
+	// CSPIPoolDrained is the condition on CSPI requested to be drained. It is
+	// True once no volume replica is left on the pool and the pool is safe
+	// to be removed from the CSPC.
+	CSPIPoolDrained cstor.CStorPoolInstanceConditionType = "PoolDrained"
+)
+
+// IsDrainRequested returns true if the CSPI is requested to be drained
+func IsDrainRequested(cspi *cstor.CStorPoolInstance) bool {
+	return cspi.GetAnnotations()[DrainAnnotation] == "true"
+}
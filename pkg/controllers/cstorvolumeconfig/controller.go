@@ -26,15 +26,17 @@ import (
 	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	apitypes "github.com/openebs/api/v3/pkg/apis/types"
 	"github.com/openebs/api/v3/pkg/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/util/hash"
 	"github.com/openebs/cstor-operators/pkg/version"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/klog/v2"
 
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	policy "k8s.io/api/policy/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -241,12 +243,32 @@ func (c *CVCController) syncCVC(cvc *apis.CStorVolumeConfig) error {
 		return err
 	}
 
+	if c.isMigratePending(cvc) {
+		// migration adds and removes replica pools of CVC one step at a time,
+		// replicas are scaled below as per the updated CVC
+		migratedCVC, err := c.migrateReplica(cvc)
+		if err != nil {
+			c.recorder.Eventf(cvc, corev1.EventTypeWarning, string(cvcutil.CStorVolumeConfigMigrateFailed), err.Error())
+			return err
+		}
+		cvc = migratedCVC
+	}
+
 	if c.isCVCScalePending(cvc) {
 		// process scale-up/scale-down of volume replicas only if there is
 		// change in curent and desired state of replicas pool information
 		_ = c.scaleVolumeReplicas(cvc)
 	}
 
+	if c.isPromotePending(cvc) {
+		// promote the clone volume to an independent volume on request
+		err = c.promoteVolume(cvc)
+		if err != nil {
+			c.recorder.Eventf(cvc, corev1.EventTypeWarning, string(CStorVolumeConfigPromoteFailed), err.Error())
+			return err
+		}
+	}
+
 	// sync policy changes from cvc.spec.policy e.g. tunables like toleration, resource requirements etc
 	return c.syncPolicySpec(cvc)
 }
@@ -282,12 +304,18 @@ func (c *CVCController) updateCVCObj(
 // createVolumeOperation trigers the all required resource create operation.
 //  1. Create volume service.
 //  2. Create cstorvolume resource with required iscsi information.
-//  3. Create target deployment.
-//  4. Create cstorvolumeconfig resource.
-//  5. Create PDB provisioning volume is HA volume.
-//  6. Update the cstorvolumeconfig with claimRef info, PDB label(only for HA
+//  3. Create cstorvolumereplica resources.
+//  4. Create target deployment placed as per the replica pools.
+//  5. Update the cstorvolumeconfig with claimRef info, PDB label(only for HA
 //     volumes) and bound with cstorvolume.
-func (c *CVCController) createVolumeOperation(cvc *apis.CStorVolumeConfig) (*apis.CStorVolumeConfig, error) {
+//  6. Sync PDB of the pools of CSPC if provisioning volume is HA volume.
+func (c *CVCController) createVolumeOperation(cvc *apis.CStorVolumeConfig) (_ *apis.CStorVolumeConfig, err error) {
+	// trace context is stored on cvc so that the cstorvolume and replicas
+	// created below carry it to the volume and pool managers
+	ctx, span := tracing.Start(context.TODO(), cvc, "CreateVolume",
+		attribute.Int("replicaCount", cvc.Spec.Provision.ReplicaCount))
+	defer func() { tracing.End(span, err) }()
+	tracing.Inject(ctx, cvc)
 
 	policyName := cvc.Annotations[string(apitypes.VolumePolicyKey)]
 	volumePolicy, err := c.getVolumePolicy(policyName, cvc)
@@ -295,6 +323,8 @@ func (c *CVCController) createVolumeOperation(cvc *apis.CStorVolumeConfig) (*api
 		return nil, err
 	}
 
+	setTargetAnnotations(volumePolicy, cvc)
+
 	klog.V(2).Infof("creating cstorvolume service resource")
 	svcObj, err := c.getOrCreateTargetService(cvc)
 	if err != nil {
@@ -307,37 +337,33 @@ func (c *CVCController) createVolumeOperation(cvc *apis.CStorVolumeConfig) (*api
 		return nil, err
 	}
 
-	klog.V(2).Infof("creating cstorvolume target deployment")
-	_, err = c.getOrCreateCStorTargetDeployment(cvObj, &volumePolicy.Spec)
-	if err != nil {
-		return nil, err
-	}
-
 	klog.V(2).Infof("creating cstorvolume replica resource")
 	err = c.distributePendingCVRs(cvc, cvObj, svcObj, volumePolicy)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch the volume replica pool names and use them in PDB and updating in
-	// spec and status of CVC
+	// Fetch the volume replica pool names and use them in updating spec and
+	// status of CVC
 	poolNames, err := GetVolumeReplicaPoolNames(c.clientset, cvc.Name, openebsNamespace)
 	if err != nil {
 		return nil, errors.Wrapf(err,
 			"failed to get volume replica pool names of volume %s", cvObj.Name)
 	}
 
-	if isHAVolume(cvc) {
-		// TODO: When multiple threads or multiple CVC controllers are set then
-		// we have to revist entier PDB code path
-		var pdbObj *policy.PodDisruptionBudget
-		pdbObj, err = c.getOrCreatePodDisruptionBudget(getCSPC(cvc), poolNames)
-		if err != nil {
-			return nil, errors.Wrapf(err,
-				"failed to create PDB for volume: %s", cvc.Name)
-		}
+	// TODO: Below function needs to be converted into
+	// cvc.addReplicaPoolInfo(poolNames) while moving to cstor-operators
+	// repo(Currently in Maya writing functions in API package is not encouraged)
 
-		addPDBLabelOnCVC(cvc, pdbObj)
+	// update volume replica pool information on cvc spec and status, target
+	// placement is computed from it only while creating the target deployment
+	cvc.Spec.Policy = volumePolicy.Spec
+	addReplicaPoolInfo(cvc, poolNames)
+
+	klog.V(2).Infof("creating cstorvolume target deployment")
+	_, err = c.getOrCreateCStorTargetDeployment(cvc, cvObj, &cvc.Spec.Policy)
+	if err != nil {
+		return nil, err
 	}
 
 	volumeRef, err := ref.GetReference(scheme.Scheme, cvObj)
@@ -348,23 +374,27 @@ func (c *CVCController) createVolumeOperation(cvc *apis.CStorVolumeConfig) (*api
 	// update the cstorvolume reference, phase as "Bound" and desired
 	// capacity
 	cvc.Spec.CStorVolumeRef = volumeRef
-	cvc.Spec.Policy = volumePolicy.Spec
 	cvc.Status.Phase = apis.CStorVolumeConfigPhaseBound
 	cvc.Status.Capacity = cvc.Spec.Capacity
 
-	// TODO: Below function needs to be converted into
-	// cvc.addReplicaPoolInfo(poolNames) while moving to cstor-operators
-	// repo(Currently in Maya writing functions in API package is not encouraged)
-
-	// update volume replica pool information on cvc spec and status
-	addReplicaPoolInfo(cvc, poolNames)
 	// add hash label in cvc generated from volume policy spec
 	addPolicySpecHash(cvc)
+	if isHAVolume(cvc) {
+		addPDBLabelOnCVC(cvc, getPoolPDBName(getCSPC(cvc)))
+	}
 
 	err = c.updateCVCObj(cvc, cvObj)
 	if err != nil {
 		return nil, err
 	}
+
+	if isHAVolume(cvc) {
+		// PDB is synced periodically as well, hence failure to sync it
+		// doesn't fail the provisioning
+		if err := c.syncPoolPDB(getCSPC(cvc)); err != nil {
+			klog.Errorf("failed to sync PDB of pools for volume %s: %v", cvc.Name, err)
+		}
+	}
 	return cvc, nil
 }
 
@@ -415,19 +445,40 @@ func (c *CVCController) patchTargetDeploymentSpec(cvc *apis.CStorVolumeConfig) e
 		return errors.Wrapf(err, "failed to get cstorvolume {%v}", cvc.Name)
 	}
 
-	newDeployObj, err := c.BuildTargetDeployment(vol, &cvc.Spec.Policy)
+	newDeployObj, err := c.BuildTargetDeployment(cvc, vol, &cvc.Spec.Policy)
 	if err != nil {
 		return errors.Wrapf(err, "failed to build target deployment {%v}", vol.Name)
 	}
 
+	err = c.applyTargetPlacement(orignalDeployObj, newDeployObj)
+	if err != nil {
+		return err
+	}
+
+	patchBytes, err := getTargetDeploymentPatch(orignalDeployObj, newDeployObj)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.kubeclientset.AppsV1().Deployments(cvc.Namespace).Patch(context.TODO(), orignalDeployObj.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to patch volume target deployment")
+	}
+
+	return nil
+}
+
+// getTargetDeploymentPatch returns the strategic merge patch to update the
+// original target deployment to the updated one
+func getTargetDeploymentPatch(orignalDeployObj, newDeployObj *appsv1.Deployment) ([]byte, error) {
 	oldData, err := json.Marshal(orignalDeployObj)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal original deployment %s", orignalDeployObj.Name)
+		return nil, errors.Wrapf(err, "failed to marshal original deployment %s", orignalDeployObj.Name)
 	}
 
 	newData, err := json.Marshal(newDeployObj)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal updated deployment %s", newDeployObj.Name)
+		return nil, errors.Wrapf(err, "failed to marshal updated deployment %s", newDeployObj.Name)
 	}
 
 	// CreateTwoWayMergePatch creates a patch that can be passed to StrategicMergePatch from an original
@@ -436,15 +487,9 @@ func (c *CVCController) patchTargetDeploymentSpec(cvc *apis.CStorVolumeConfig) e
 	// if either of the two documents is invalid.
 	patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, appsv1.Deployment{})
 	if err != nil {
-		return errors.Wrap(err, "failed to create strategic merge patch data")
+		return nil, errors.Wrap(err, "failed to create strategic merge patch data")
 	}
-
-	_, err = c.kubeclientset.AppsV1().Deployments(cvc.Namespace).Patch(context.TODO(), orignalDeployObj.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
-	if err != nil {
-		return errors.Wrap(err, "failed to patch volume target deployment")
-	}
-
-	return nil
+	return patchBytes, nil
 }
 
 func (c *CVCController) getVolumePolicy(
@@ -517,11 +562,12 @@ func (c *CVCController) removeClaimFinalizer(
 	cvc *apis.CStorVolumeConfig,
 ) error {
 	if isHAVolume(cvc) {
-		err := c.deletePDBIfNotInUse(cvc)
+		// volume being deleted no longer contributes to the PDB of pools
+		err := c.syncPoolPDB(getCSPC(cvc))
 		if err != nil {
 			return errors.Wrapf(err,
-				"failed to verify whether PDB %s is in use by other volumes",
-				getPDBName(cvc),
+				"failed to sync PDB %s of pools after deleting volume %s",
+				getPoolPDBName(getCSPC(cvc)), cvc.Name,
 			)
 		}
 	}
@@ -661,7 +707,11 @@ func (c *CVCController) resizeCVC(cvc *apis.CStorVolumeConfig) error {
 	c.recorder.Event(cvc, corev1.EventTypeNormal, string(apis.CStorVolumeConfigResizing),
 		fmt.Sprintf("CVCController is resizing volume %s", cvc.Name))
 
-	err = c.resizeCV(cv, desiredCVCSize)
+	ctx, span := tracing.Start(context.TODO(), nil, "ResizeVolume",
+		attribute.String("k8s.object.name", cvc.Name),
+		attribute.String("capacity", desiredCVCSize.String()))
+	err = c.resizeCV(ctx, cv, desiredCVCSize)
+	tracing.End(span, err)
 	if err != nil {
 		// Record an event to indicate that resize operation is failed.
 		c.recorder.Eventf(cvc, corev1.EventTypeWarning, string(apis.CStorVolumeConfigResizeFailed), err.Error())
@@ -770,9 +820,11 @@ func getPatchData(oldObj, newObj interface{}) ([]byte, []byte, error) {
 }
 
 // resizeCV resize the cstor volume to desired size, and update CV's capacity
-func (c *CVCController) resizeCV(cv *apis.CStorVolume, newCapacity resource.Quantity) error {
+// along with the trace context of resize operation
+func (c *CVCController) resizeCV(ctx context.Context, cv *apis.CStorVolume, newCapacity resource.Quantity) error {
 	newCV := cv.DeepCopy()
 	newCV.Spec.Capacity = newCapacity
+	tracing.Inject(ctx, newCV)
 
 	patchBytes, _, err := getPatchData(cv, newCV)
 	if err != nil {
@@ -786,40 +838,12 @@ func (c *CVCController) resizeCV(cv *apis.CStorVolume, newCapacity resource.Quan
 	return nil
 }
 
-// deletePDBIfNotInUse deletes the PDB if no volume is refering to the
-// cStorvolumeconfig PDB
-func (c *CVCController) deletePDBIfNotInUse(cvc *apis.CStorVolumeConfig) error {
-	//TODO: If HALease is enabled active-active then below code needs to be
-	//revist
-	pdbName := getPDBName(cvc)
-	cvcLabelSelector := string(apitypes.PodDisruptionBudgetKey) + "=" + pdbName
-	cvcList, err := c.clientset.CstorV1().CStorVolumeConfigs(openebsNamespace).
-		List(context.TODO(), metav1.ListOptions{LabelSelector: cvcLabelSelector})
-	if err != nil {
-		return errors.Wrapf(err,
-			"failed to list volumes refering to PDB %s", pdbName)
-	}
-	if len(cvcList.Items) == 1 {
-		err = c.kubeclientset.PolicyV1().PodDisruptionBudgets(openebsNamespace).
-			Delete(context.TODO(), pdbName, metav1.DeleteOptions{})
-
-		if k8serror.IsNotFound(err) {
-			klog.Infof("pdb %s of volume %s was already deleted", pdbName, cvc.Name)
-			return nil
-		}
-		if err != nil {
-			return err
-		}
-		klog.Infof("Successfully deleted the PDB %s of volume %s", pdbName, cvc.Name)
-	}
-	return nil
-}
-
 // scaleVolumeReplicas identifies whether it is scaleup or scaledown case of
 // volume replicas. If user added entry of pool info under the spec then changes
 // are treated as scaleup case. If user removed poolInfo entry from spec then
 // changes are treated as scale down case. If user just modifies the pool entry
-// info under the spec then it is a kind of migration which is not yet supported
+// info under the spec then it is a kind of migration which is supported only
+// via migrate-replica annotation
 func (c *CVCController) scaleVolumeReplicas(cvc *apis.CStorVolumeConfig) error {
 	var err error
 	if len(cvc.Spec.Policy.ReplicaPoolInfo) > len(cvc.Status.PoolInfo) {
@@ -827,8 +851,9 @@ func (c *CVCController) scaleVolumeReplicas(cvc *apis.CStorVolumeConfig) error {
 	} else if len(cvc.Spec.Policy.ReplicaPoolInfo) < len(cvc.Status.PoolInfo) {
 		cvc, err = c.scaleDownVolumeReplicas(cvc)
 	} else {
-		c.recorder.Event(cvc, corev1.EventTypeWarning, "Migration",
-			"Migration of volume replicas is not yet supported")
+		c.recorder.Eventf(cvc, corev1.EventTypeWarning, "Migration",
+			"Migration of volume replicas by modifying pool names is not supported, use annotation %s",
+			cvcutil.MigrateReplicaAnnotation)
 		return nil
 	}
 	if err != nil {
@@ -432,6 +432,256 @@ func TestMergeResizeCondition(t *testing.T) {
 
 }
 
+func TestMergePromoteCondition(t *testing.T) {
+	currentTime := metav1.Now()
+
+	cvc := getCVC([]apis.CStorVolumeConfigCondition{
+		{
+			Type:               apis.CStorVolumeConfigResizing,
+			LastTransitionTime: currentTime,
+		},
+		{
+			Type:               CStorVolumeConfigPromoting,
+			LastTransitionTime: currentTime,
+		},
+	})
+
+	testCases := []conditionMergeTestCase{
+		{
+			description: "when removing promote conditions",
+			cvc:         cvc.DeepCopy(),
+			finalCondtions: []apis.CStorVolumeConfigCondition{
+				{
+					Type:               apis.CStorVolumeConfigResizing,
+					LastTransitionTime: currentTime,
+				},
+			},
+		},
+		{
+			description: "when replacing promoting condition with failed condition",
+			cvc:         cvc.DeepCopy(),
+			newConditions: []apis.CStorVolumeConfigCondition{
+				{
+					Type:    CStorVolumeConfigPromoteFailed,
+					Message: "source volume has other clones",
+				},
+			},
+			finalCondtions: []apis.CStorVolumeConfigCondition{
+				{
+					Type:               apis.CStorVolumeConfigResizing,
+					LastTransitionTime: currentTime,
+				},
+				{
+					Type:    CStorVolumeConfigPromoteFailed,
+					Message: "source volume has other clones",
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		updateConditions := mergePromoteConditionsOfCVC(testcase.cvc.Status.Conditions, testcase.newConditions)
+
+		if !reflect.DeepEqual(updateConditions, testcase.finalCondtions) {
+			t.Errorf("Expected updated conditions for test %s to be %v but got %v",
+				testcase.description,
+				testcase.finalCondtions, updateConditions)
+		}
+	}
+}
+
+func TestBuildTargetDeploymentStandby(t *testing.T) {
+	policySpec := getDefaultPolicySpec()
+	testCases := map[string]struct {
+		annotations     map[string]string
+		expectedReplica int32
+		expectedRole    string
+	}{
+		"when warm standby target is not enabled": {
+			expectedReplica: 1,
+		},
+		"when warm standby target is enabled": {
+			annotations:     map[string]string{TargetStandbyKey: "true"},
+			expectedReplica: 2,
+			expectedRole:    TargetRoleStandby,
+		},
+	}
+	c := &CVCController{}
+	for name, test := range testCases {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			vol := &apis.CStorVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pvc-1",
+					Namespace:   namespace,
+					Annotations: test.annotations,
+				},
+			}
+			deployObj, err := c.BuildTargetDeployment(&apis.CStorVolumeConfig{}, vol, &policySpec)
+			if err != nil {
+				t.Fatalf("failed to build target deployment: %v", err)
+			}
+			if *deployObj.Spec.Replicas != test.expectedReplica {
+				t.Errorf("expected %d replicas but got %d", test.expectedReplica, *deployObj.Spec.Replicas)
+			}
+			if role := deployObj.Spec.Template.Labels[TargetRoleKey]; role != test.expectedRole {
+				t.Errorf("expected target role %q but got %q", test.expectedRole, role)
+			}
+			hasAntiAffinity := deployObj.Spec.Template.Spec.Affinity.PodAntiAffinity != nil
+			if hasAntiAffinity != (test.expectedRole != "") {
+				t.Errorf("expected pod anti affinity %t but got %t", test.expectedRole != "", hasAntiAffinity)
+			}
+			shareProcessNamespace := deployObj.Spec.Template.Spec.ShareProcessNamespace != nil &&
+				*deployObj.Spec.Template.Spec.ShareProcessNamespace
+			if shareProcessNamespace != (test.expectedRole != "") {
+				t.Errorf("expected shared process namespace %t but got %t", test.expectedRole != "", shareProcessNamespace)
+			}
+		})
+	}
+}
+
+func TestGetTargetNodeAffinity(t *testing.T) {
+	cspis := []runtime.Object{}
+	for i, hostName := range []string{"node-2", "node-1", "node-1"} {
+		cspis = append(cspis, &apis.CStorPoolInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-" + strconv.Itoa(i), Namespace: namespace},
+			Spec:       apis.CStorPoolInstanceSpec{HostName: hostName},
+		})
+	}
+	c := &CVCController{clientset: openebsFakeClientset.NewSimpleClientset(cspis...)}
+	policySpec := &apis.CStorVolumePolicySpec{
+		ReplicaPoolInfo: []apis.ReplicaPoolInfo{
+			{PoolName: "pool-0"}, {PoolName: "pool-1"}, {PoolName: "pool-2"},
+		},
+	}
+
+	testCases := map[string]struct {
+		placement    string
+		nodeID       string
+		expectedTerm *corev1.NodeSelectorTerm
+	}{
+		"when placement is not requested": {},
+		"when volume is not yet published for app placement": {
+			placement: TargetPlacementApp,
+		},
+		"when volume is published for app placement": {
+			placement: TargetPlacementApp,
+			nodeID:    "node-3",
+			expectedTerm: &corev1.NodeSelectorTerm{
+				MatchFields: []corev1.NodeSelectorRequirement{
+					{Key: nodeNameFieldKey, Operator: corev1.NodeSelectorOpIn, Values: []string{"node-3"}},
+				},
+			},
+		},
+		"when replica anti affinity placement is requested": {
+			placement: TargetPlacementReplicaAntiAffinity,
+			expectedTerm: &corev1.NodeSelectorTerm{
+				MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: hostNameLabelKey, Operator: corev1.NodeSelectorOpNotIn, Values: []string{"node-1", "node-2"}},
+				},
+			},
+		},
+	}
+	for name, test := range testCases {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			cvc := &apis.CStorVolumeConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pvc-1",
+					Annotations: map[string]string{TargetPlacementKey: test.placement},
+				},
+				Publish: apis.CStorVolumeConfigPublish{NodeID: test.nodeID},
+			}
+			nodeAffinity, err := c.getTargetNodeAffinity(cvc, policySpec)
+			if err != nil {
+				t.Fatalf("failed to get target node affinity: %v", err)
+			}
+			if test.expectedTerm == nil {
+				if nodeAffinity != nil {
+					t.Errorf("expected no node affinity but got %v", nodeAffinity)
+				}
+				return
+			}
+			if nodeAffinity == nil {
+				t.Fatalf("expected node affinity with term %v but got nil", test.expectedTerm)
+			}
+			got := nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Preference
+			if !reflect.DeepEqual(got, *test.expectedTerm) {
+				t.Errorf("expected node selector term %v but got %v", *test.expectedTerm, got)
+			}
+		})
+	}
+}
+
+func TestPatchTargetDeploymentSpecPlacement(t *testing.T) {
+	openebsNamespace = namespace
+	testCases := map[string]struct {
+		tolerations      []corev1.Toleration
+		expectedHostName string
+	}{
+		"when only the replicas are moved to other pools": {
+			expectedHostName: "node-1",
+		},
+		"when the policy changes restart the target": {
+			tolerations: []corev1.Toleration{
+				{Key: "storage", Operator: corev1.TolerationOpExists},
+			},
+			expectedHostName: "node-2",
+		},
+	}
+	for name, test := range testCases {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			cvc := &apis.CStorVolumeConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pvc-1",
+					Namespace:   namespace,
+					Annotations: map[string]string{TargetPlacementKey: TargetPlacementReplicaAntiAffinity},
+				},
+				Spec: apis.CStorVolumeConfigSpec{
+					Policy: apis.CStorVolumePolicySpec{
+						ReplicaPoolInfo: []apis.ReplicaPoolInfo{{PoolName: "pool-1"}},
+					},
+				},
+			}
+			vol := &apis.CStorVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: namespace},
+			}
+			openebsObjects := []runtime.Object{vol}
+			for _, hostName := range []string{"node-1", "node-2"} {
+				openebsObjects = append(openebsObjects, &apis.CStorPoolInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool-" + strings.TrimPrefix(hostName, "node-"), Namespace: namespace},
+					Spec:       apis.CStorPoolInstanceSpec{HostName: hostName},
+				})
+			}
+			c := &CVCController{clientset: openebsFakeClientset.NewSimpleClientset(openebsObjects...)}
+			deployObj, err := c.BuildTargetDeployment(cvc, vol, &cvc.Spec.Policy)
+			if err != nil {
+				t.Fatalf("failed to build target deployment: %v", err)
+			}
+			deployObj.Namespace = namespace
+			c.kubeclientset = fake.NewSimpleClientset(deployObj)
+
+			cvc.Spec.Policy.ReplicaPoolInfo = []apis.ReplicaPoolInfo{{PoolName: "pool-2"}}
+			cvc.Spec.Policy.Target.Tolerations = test.tolerations
+			err = c.patchTargetDeploymentSpec(cvc)
+			if err != nil {
+				t.Fatalf("failed to patch target deployment: %v", err)
+			}
+
+			deployObj, err = c.kubeclientset.AppsV1().Deployments(namespace).
+				Get(context.TODO(), "pvc-1-target", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get target deployment: %v", err)
+			}
+			term := getDeployNodeAffinity(deployObj).PreferredDuringSchedulingIgnoredDuringExecution[0].Preference
+			if got := term.MatchExpressions[0].Values; !reflect.DeepEqual(got, []string{test.expectedHostName}) {
+				t.Errorf("expected target to avoid %q but it avoids %v", test.expectedHostName, got)
+			}
+		})
+	}
+}
+
 func getCVC(conditions []apis.CStorVolumeConfigCondition) *apis.CStorVolumeConfig {
 	cvc := &apis.CStorVolumeConfig{
 		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "openebs"},
@@ -25,12 +25,16 @@ import (
 	deploy "github.com/openebs/api/v3/pkg/kubernetes/apps"
 	apicore "github.com/openebs/api/v3/pkg/kubernetes/core"
 	"github.com/openebs/api/v3/pkg/util"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/version"
 	errors "github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 var (
@@ -285,6 +289,26 @@ func getContainerPort(port int32) []corev1.ContainerPort {
 	}
 }
 
+// getTargetMgmtLivenessProbe returns the liveness probe of volume manager
+// which fails only if the manager is wedged, so that the manager container
+// is restarted without restarting the target container. Readiness of the
+// volume manager isn't probed as an unready target pod is removed from the
+// endpoints of target service disrupting the IOs.
+func getTargetMgmtLivenessProbe() *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: health.LivenessPath,
+				Port: intstr.FromInt(health.DefaultPort),
+			},
+		},
+		FailureThreshold:    3,
+		InitialDelaySeconds: 30,
+		PeriodSeconds:       60,
+		TimeoutSeconds:      10,
+	}
+}
+
 // getResourceRequirementForCStorTarget returns resource requirement for cstor
 // target container.
 func getResourceRequirementForCStorTarget(policySpec *apis.CStorVolumePolicySpec) *corev1.ResourceRequirements {
@@ -323,6 +347,7 @@ func getPriorityClass(policySpec *apis.CStorVolumePolicySpec) string {
 // getOrCreateCStorTargetDeployment get or create the cstor target deployment
 // for a given cstorvolume.
 func (c *CVCController) getOrCreateCStorTargetDeployment(
+	cvc *apis.CStorVolumeConfig,
 	vol *apis.CStorVolume,
 	policySpec *apis.CStorVolumePolicySpec,
 ) (*appsv1.Deployment, error) {
@@ -339,7 +364,7 @@ func (c *CVCController) getOrCreateCStorTargetDeployment(
 	}
 
 	if k8serror.IsNotFound(err) {
-		deployObj, err = c.BuildTargetDeployment(vol, policySpec)
+		deployObj, err = c.BuildTargetDeployment(cvc, vol, policySpec)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to build deployment object")
 		}
@@ -355,26 +380,48 @@ func (c *CVCController) getOrCreateCStorTargetDeployment(
 // BuildTargetDeployment builds the target deploytment object for a given volume
 // and policy
 func (c *CVCController) BuildTargetDeployment(
+	cvc *apis.CStorVolumeConfig,
 	vol *apis.CStorVolume,
 	policySpec *apis.CStorVolumePolicySpec,
 ) (*appsv1.Deployment, error) {
 
+	replicas := deployreplicas
+	templateLabels := getDeployTemplateLabels(vol.Name, vol.GetLabels()[openebsPVC])
+	affinity := getTargetTemplateAffinity(policySpec)
+	nodeAffinity, err := c.getTargetNodeAffinity(cvc, policySpec)
+	if err != nil {
+		return nil, err
+	}
+	affinity.NodeAffinity = nodeAffinity
+	mgmtEnvs := getDeployTemplateEnvs(string(vol.UID))
+	// volume manager exports traces to the same collector as the operator
+	mgmtEnvs = append(mgmtEnvs, tracing.Env()...)
+	mgmtEnvs = append(mgmtEnvs, alertlog.Env()...)
+	// volumes having warm standby target run one more target pod which is
+	// spread on a different node and takes over once it gets the Lease
+	if hasTargetStandby(vol) {
+		replicas = standbyDeployReplicas
+		templateLabels[TargetRoleKey] = TargetRoleStandby
+		affinity.PodAntiAffinity = getTargetStandbyAffinity(vol.Name)
+		mgmtEnvs = append(mgmtEnvs, getTargetStandbyEnvs(vol.Name)...)
+	}
+
 	deployObj := deploy.NewDeployment().
 		WithName(vol.Name + "-target").
 		WithLabelsNew(getDeployLabels(vol.Name, vol.GetLabels()[openebsPVC])).
 		WithAnnotationsNew(getDeployAnnotation()).
 		WithOwnerReferenceNew(getDeployOwnerReference(vol)).
-		WithReplicas(&deployreplicas).
+		WithReplicas(&replicas).
 		WithStrategyType(
 			appsv1.RecreateDeploymentStrategyType,
 		).
 		WithSelectorMatchLabelsNew(getDeployMatchLabels(vol.Name)).
 		WithPodTemplateSpec(
 			apicore.NewPodTemplateSpec().
-				WithLabelsNew(getDeployTemplateLabels(vol.Name, vol.GetLabels()[openebsPVC])).
+				WithLabelsNew(templateLabels).
 				WithAnnotationsNew(getDeployTemplateAnnotations()).
 				WithServiceAccountName(util.GetServiceAccountName()).
-				WithAffinity(getTargetTemplateAffinity(policySpec)).
+				WithAffinity(affinity).
 				WithPriorityClassName(getPriorityClass(policySpec)).
 				WithNodeSelectorByValue(policySpec.Target.NodeSelector).
 				WithTolerationsNew(getDeployTolerations(policySpec)...).
@@ -402,7 +449,8 @@ func (c *CVCController) BuildTargetDeployment(
 						WithName(MgmtContainerName).
 						WithImagePullPolicy(corev1.PullIfNotPresent).
 						WithPortsNew(getContainerPort(80)).
-						WithEnvsNew(getDeployTemplateEnvs(string(vol.UID))).
+						WithLivenessProbe(getTargetMgmtLivenessProbe()).
+						WithEnvsNew(mgmtEnvs).
 						WithResourcesByRef(getAuxResourceRequirement(policySpec)).
 						WithPrivilegedSecurityContext(&privileged).
 						WithVolumeMountsNew(getTargetMgmtMounts()),
@@ -423,5 +471,11 @@ func (c *CVCController) BuildTargetDeployment(
 				),
 		).
 		Build()
+	if hasTargetStandby(vol) {
+		// volume manager of a target pod losing the Lease fences the target
+		// by killing istgt, which requires seeing the processes of istgt
+		shareProcessNamespace := true
+		deployObj.Spec.Template.Spec.ShareProcessNamespace = &shareProcessNamespace
+	}
 	return deployObj, nil
 }
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/api/v3/pkg/util"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DrainingPool is the event reason when replica of the volume is migrated
+	// out of the pool being drained
+	DrainingPool = "DrainingPool"
+
+	// drainSyncInterval is the interval at which migrations of replicas out of
+	// the pools being drained are planned
+	drainSyncInterval = 30 * time.Second
+)
+
+// runDrainer plans the migration of replicas out of the pools being drained
+// for every CSPC once per drainSyncInterval until stopCh is closed
+func (c *CVCController) runDrainer(maxMigrations int, stopCh <-chan struct{}) {
+	klog.Infof("Starting pool drainer, max migrations per cspc: %d", maxMigrations)
+	wait.Until(func() { c.drainPools(maxMigrations) }, drainSyncInterval, stopCh)
+	klog.Info("Shutting down pool drainer")
+}
+
+// drainPools plans the migration of replicas out of the pools being drained
+// of every CSPC
+func (c *CVCController) drainPools(maxMigrations int) {
+	cspcList, err := c.clientset.CstorV1().CStorPoolClusters(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("failed to list cstorpoolclusters to drain pools: %v", err)
+		return
+	}
+	for _, cspc := range cspcList.Items {
+		if err := c.drainCSPC(cspc.Name, maxMigrations); err != nil {
+			klog.Errorf("failed to drain pools of cstorpoolcluster %s: %v", cspc.Name, err)
+		}
+	}
+}
+
+// isDrainPending returns true if replicas of the bound volume can be
+// migrated out of the pools being drained, i.e. no migration or scaling of
+// the replicas is in progress
+func (c *CVCController) isDrainPending(cvc *apis.CStorVolumeConfig) bool {
+	return cvc.Status.Phase == apis.CStorVolumeConfigPhaseBound &&
+		!c.isMigratePending(cvc) &&
+		!c.isCVCScalePending(cvc)
+}
+
+// drainCSPC requests migration of the volume replicas on the pools of the
+// CSPC being drained to other pools of the CSPC. The target pools of all the
+// replicas are selected here so that the capacity committed to the
+// migrations in progress, and to the ones requested in this run, is not
+// handed out twice. Migrations are throttled, no more migrations are
+// requested once maxMigrations migrations or scaling of volume replicas are
+// in progress on the CSPC. A volume migrates one replica at a time via the
+// migrate-replica annotation, the next replica of the volume on a pool being
+// drained is migrated once the migration is finished.
+func (c *CVCController) drainCSPC(cspcName string, maxMigrations int) error {
+	poolList, err := c.listCStorPools(cspcName)
+	if err != nil {
+		return err
+	}
+	drainingPools := []string{}
+	for _, pool := range poolList.Items {
+		if cspiutil.IsDrainRequested(&pool) {
+			drainingPools = append(drainingPools, pool.Name)
+		}
+	}
+	if len(drainingPools) == 0 {
+		return nil
+	}
+	cvcList, err := c.clientset.CstorV1().CStorVolumeConfigs(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: types.CStorPoolClusterLabelKey + "=" + cspcName,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cvc of cspc %s", cspcName)
+	}
+
+	// free capacity of the pools excludes the capacity committed to the
+	// replicas which are yet to be created or rebuilt
+	poolList = poolList.DeepCopy()
+	inProgress := 0
+	for i := range cvcList.Items {
+		cvc := &cvcList.Items[i]
+		if !c.isMigratePending(cvc) && !c.isCVCScalePending(cvc) {
+			continue
+		}
+		inProgress++
+		for _, poolName := range getCommittedPools(cvc) {
+			commitCapacity(poolList, poolName, cvc)
+		}
+	}
+
+	sort.Slice(cvcList.Items, func(i, j int) bool {
+		return cvcList.Items[i].Name < cvcList.Items[j].Name
+	})
+	for i := range cvcList.Items {
+		if inProgress >= maxMigrations {
+			klog.V(4).Infof("Throttling drain of cspc %s, %d migration(s) in progress", cspcName, inProgress)
+			return nil
+		}
+		cvc := &cvcList.Items[i]
+		if !c.isDrainPending(cvc) {
+			continue
+		}
+		var fromPool string
+		for _, poolName := range drainingPools {
+			if util.ContainsString(cvc.Status.PoolInfo, poolName) {
+				fromPool = poolName
+				break
+			}
+		}
+		if fromPool == "" {
+			continue
+		}
+		toPool, err := selectDrainTargetPool(cvc, fromPool, poolList)
+		if err != nil {
+			// draining is retried on next run as pools may come online or
+			// free up capacity
+			c.recorder.Eventf(cvc, corev1.EventTypeWarning, DrainingPool,
+				"Can't migrate replica out of pool %s: %v", fromPool, err)
+			continue
+		}
+		if _, err := c.requestMigration(cvc, fromPool, toPool); err != nil {
+			return err
+		}
+		inProgress++
+		commitCapacity(poolList, toPool, cvc)
+		klog.Infof("Pool %s is being drained, migrating replica of volume %s to pool %s",
+			fromPool, cvc.Name, toPool)
+		c.recorder.Eventf(cvc, corev1.EventTypeNormal, DrainingPool,
+			"Pool %s is being drained, migrating replica to pool %s", fromPool, toPool)
+	}
+	return nil
+}
+
+// getCommittedPools returns the pools the replicas of the volume are being
+// added to i.e. the target pool of the migration in progress and the pools
+// added to the replica pools of CVC which don't have the replica yet
+func getCommittedPools(cvc *apis.CStorVolumeConfig) []string {
+	pools := []string{}
+	if _, toPool, err := parseMigrateReplica(cvc.GetAnnotations()[cvcutil.MigrateReplicaAnnotation]); err == nil {
+		pools = append(pools, toPool)
+	}
+	for _, poolName := range cvc.GetDesiredReplicaPoolNames() {
+		if !util.ContainsString(cvc.Status.PoolInfo, poolName) && !util.ContainsString(pools, poolName) {
+			pools = append(pools, poolName)
+		}
+	}
+	return pools
+}
+
+// commitCapacity subtracts the capacity of the volume from the free capacity
+// of the pool
+func commitCapacity(poolList *apis.CStorPoolInstanceList, poolName string, cvc *apis.CStorVolumeConfig) {
+	capacity := cvc.Spec.Capacity[corev1.ResourceStorage]
+	for i := range poolList.Items {
+		if poolList.Items[i].Name != poolName {
+			continue
+		}
+		free := &poolList.Items[i].Status.Capacity.Free
+		free.Sub(capacity)
+		if free.Sign() < 0 {
+			free.Set(0)
+		}
+	}
+}
+
+// selectDrainTargetPool returns the pool to migrate the volume replica on the
+// pool being drained to. Pool having the most free capacity is selected among
+// the pools returned by replicaTargetPools.
+func selectDrainTargetPool(cvc *apis.CStorVolumeConfig, fromPool string,
+	poolList *apis.CStorPoolInstanceList) (string, error) {
+	candidates := replicaTargetPools(cvc, fromPool, poolList)
+	if len(candidates) == 0 {
+		capacity := cvc.Spec.Capacity[corev1.ResourceStorage]
+		return "", errors.Errorf("no online pool of cstorpoolcluster %s with %s free capacity "+
+			"on a node without replica of volume %s", getCSPC(cvc), capacity.String(), cvc.Name)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if cmp := candidates[i].Status.Capacity.Free.Cmp(candidates[j].Status.Capacity.Free); cmp != 0 {
+			return cmp > 0
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0].Name, nil
+}
+
+// replicaTargetPools returns the pools of poolList the volume replica on
+// fromPool can be migrated to. Pools are selected among the online pools which
+//  1. are not being drained or cordoned and don't have a replica of the volume,
+//  2. are not on the nodes of other replicas of the volume so that replicas
+//     remain spread across nodes,
+//  3. have free capacity to hold the volume.
+func replicaTargetPools(cvc *apis.CStorVolumeConfig, fromPool string,
+	poolList *apis.CStorPoolInstanceList) []apis.CStorPoolInstance {
+	replicaPools := append(cvc.GetDesiredReplicaPoolNames(), cvc.Status.PoolInfo...)
+	replicaNodes := map[string]bool{}
+	for _, pool := range poolList.Items {
+		if pool.Name != fromPool && util.ContainsString(replicaPools, pool.Name) {
+			replicaNodes[pool.Spec.HostName] = true
+		}
+	}
+	capacity := cvc.Spec.Capacity[corev1.ResourceStorage]
+
+	candidates := []apis.CStorPoolInstance{}
+	for _, pool := range poolList.Items {
+		if cspiutil.IsDrainRequested(&pool) ||
+			cspiutil.IsCordoned(&pool) ||
+			util.ContainsString(replicaPools, pool.Name) ||
+			string(pool.Status.Phase) != cspiOnline ||
+			pool.Status.ReadOnly ||
+			replicaNodes[pool.Spec.HostName] ||
+			pool.Status.Capacity.Free.Cmp(capacity) < 0 {
+			continue
+		}
+		candidates = append(candidates, pool)
+	}
+	return candidates
+}
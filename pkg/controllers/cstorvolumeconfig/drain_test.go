@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"testing"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDrainCSPI(name, host, free string, drain bool) *apis.CStorPoolInstance {
+	cspi := newMigrateCSPI(name, "cspc", apis.CStorPoolStatusOnline)
+	cspi.Spec.HostName = host
+	cspi.Status.Capacity.Free = resource.MustParse(free)
+	if drain {
+		cspi.Annotations = map[string]string{cspiutil.DrainAnnotation: "true"}
+	}
+	return cspi
+}
+
+func TestSelectDrainTargetPool(t *testing.T) {
+	offline := newDrainCSPI("cspi-offline", "node-5", "100Gi", false)
+	offline.Status.Phase = apis.CStorPoolStatusOffline
+	readOnly := newDrainCSPI("cspi-readonly", "node-6", "100Gi", false)
+	readOnly.Status.ReadOnly = true
+	cordoned := newDrainCSPI("cspi-cordoned", "node-7", "100Gi", false)
+	cordoned.Annotations = map[string]string{cspiutil.CordonAnnotation: "true"}
+
+	tests := map[string]struct {
+		pools        []*apis.CStorPoolInstance
+		expectedPool string
+	}{
+		"pool with most free capacity is selected": {
+			pools: []*apis.CStorPoolInstance{
+				newDrainCSPI("cspi-3", "node-3", "20Gi", false),
+				newDrainCSPI("cspi-4", "node-4", "30Gi", false),
+			},
+			expectedPool: "cspi-4",
+		},
+		"pools being drained are skipped": {
+			pools: []*apis.CStorPoolInstance{
+				newDrainCSPI("cspi-3", "node-3", "20Gi", false),
+				newDrainCSPI("cspi-4", "node-4", "30Gi", true),
+			},
+			expectedPool: "cspi-3",
+		},
+		"pools on node of other replica are skipped": {
+			pools: []*apis.CStorPoolInstance{
+				newDrainCSPI("cspi-3", "node-2", "30Gi", false),
+				newDrainCSPI("cspi-4", "node-1", "20Gi", false),
+			},
+			expectedPool: "cspi-4",
+		},
+		"pools without capacity are skipped": {
+			pools: []*apis.CStorPoolInstance{
+				newDrainCSPI("cspi-3", "node-3", "5Gi", false),
+			},
+		},
+		"offline, read only and cordoned pools are skipped": {
+			pools: []*apis.CStorPoolInstance{offline, readOnly, cordoned},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cvc := newMigrateCVC("", "cspi-1", "cspi-2")
+			cvc.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")}
+			poolList := &apis.CStorPoolInstanceList{Items: []apis.CStorPoolInstance{
+				*newDrainCSPI("cspi-1", "node-1", "100Gi", true),
+				*newDrainCSPI("cspi-2", "node-2", "100Gi", false),
+			}}
+			for _, pool := range test.pools {
+				poolList.Items = append(poolList.Items, *pool)
+			}
+
+			got, err := selectDrainTargetPool(cvc, "cspi-1", poolList)
+			if test.expectedPool == "" {
+				if err == nil {
+					t.Errorf("expected no pool to be selected but got %s", got)
+				}
+				return
+			}
+			if err != nil || got != test.expectedPool {
+				t.Errorf("expected pool %s but got %s, %v", test.expectedPool, got, err)
+			}
+		})
+	}
+}
+
+func TestDrainCSPC(t *testing.T) {
+	newDrainCVC := func(name, migrate string, pools ...string) *apis.CStorVolumeConfig {
+		cvc := newMigrateCVC(migrate, pools...)
+		cvc.Name = name
+		cvc.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")}
+		if migrate == "" {
+			delete(cvc.Annotations, cvcutil.MigrateReplicaAnnotation)
+		}
+		return cvc
+	}
+	tests := map[string]struct {
+		cvcs          []*apis.CStorVolumeConfig
+		maxMigrations int
+		expected      map[string]string
+	}{
+		"migrations are throttled": {
+			cvcs: []*apis.CStorVolumeConfig{
+				newDrainCVC("pvc-1", "", "cspi-1", "cspi-2"),
+				newDrainCVC("pvc-2", "", "cspi-1", "cspi-2"),
+			},
+			maxMigrations: 1,
+			expected:      map[string]string{"pvc-1": "cspi-1:cspi-3", "pvc-2": ""},
+		},
+		"capacity is committed to the planned migrations": {
+			cvcs: []*apis.CStorVolumeConfig{
+				newDrainCVC("pvc-1", "", "cspi-1", "cspi-2"),
+				newDrainCVC("pvc-2", "", "cspi-1", "cspi-2"),
+			},
+			maxMigrations: 2,
+			expected:      map[string]string{"pvc-1": "cspi-1:cspi-3", "pvc-2": "cspi-1:cspi-4"},
+		},
+		"capacity is committed to the migrations in progress": {
+			cvcs: []*apis.CStorVolumeConfig{
+				newDrainCVC("pvc-0", "cspi-2:cspi-3", "cspi-1", "cspi-2"),
+				newDrainCVC("pvc-1", "", "cspi-1", "cspi-2"),
+				newDrainCVC("pvc-2", "", "cspi-1", "cspi-2"),
+			},
+			maxMigrations: 2,
+			expected: map[string]string{
+				"pvc-0": "cspi-2:cspi-3",
+				"pvc-1": "cspi-1:cspi-4",
+				"pvc-2": "",
+			},
+		},
+		"replicas on pools not being drained are left as is": {
+			cvcs: []*apis.CStorVolumeConfig{
+				newDrainCVC("pvc-1", "", "cspi-2", "cspi-3"),
+			},
+			maxMigrations: 1,
+			expected:      map[string]string{"pvc-1": ""},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			openebsNamespace = namespace
+			f := newFixture(t)
+			f.openebsObjects = append(f.openebsObjects,
+				newDrainCSPI("cspi-1", "node-1", "100Gi", true),
+				newDrainCSPI("cspi-2", "node-2", "100Gi", false),
+				newDrainCSPI("cspi-3", "node-3", "15Gi", false),
+				newDrainCSPI("cspi-4", "node-4", "12Gi", false),
+			)
+			for _, cvc := range test.cvcs {
+				f.openebsObjects = append(f.openebsObjects, cvc)
+			}
+			f.SetFakeClient()
+			c, _, _, _ := f.newCVCController()
+
+			if err := c.drainCSPC("cspc", test.maxMigrations); err != nil {
+				t.Fatalf("%s test case failed: unexpected error %v", name, err)
+			}
+			for cvcName, expected := range test.expected {
+				cvc, err := c.clientset.CstorV1().CStorVolumeConfigs(namespace).
+					Get(context.TODO(), cvcName, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := cvc.Annotations[cvcutil.MigrateReplicaAnnotation]; got != expected {
+					t.Errorf("%s test case failed expected migration %q of %s but got %q",
+						name, expected, cvcName, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/api/v3/pkg/util"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// CStorVolumeConfigMigrateSuccess is the event reason when the volume
+	// replica is migrated successfully
+	CStorVolumeConfigMigrateSuccess = "VolumeReplicaMigrateSuccessful"
+)
+
+var knownMigrateConditions = map[apis.CStorVolumeConfigConditionType]bool{
+	cvcutil.CStorVolumeConfigMigrating:     true,
+	cvcutil.CStorVolumeConfigMigrateFailed: true,
+}
+
+// isMigratePending returns true if migration of a replica of the bound
+// volume is requested via CVC annotation or the conditions of a cancelled
+// migration are left on CVC
+func (c *CVCController) isMigratePending(cvc *apis.CStorVolumeConfig) bool {
+	if cvc.Status.Phase != apis.CStorVolumeConfigPhaseBound {
+		return false
+	}
+	return cvc.GetAnnotations()[cvcutil.MigrateReplicaAnnotation] != "" ||
+		hasCondition(cvc, cvcutil.CStorVolumeConfigMigrating) ||
+		hasCondition(cvc, cvcutil.CStorVolumeConfigMigrateFailed)
+}
+
+// parseMigrateReplica returns the pools to migrate the replica from and to
+func parseMigrateReplica(value string) (string, string, error) {
+	pools := strings.Split(value, ":")
+	if len(pools) != 2 || pools[0] == "" || pools[1] == "" {
+		return "", "", errors.Errorf("invalid value %q of annotation %s, expected <from-pool>:<to-pool>",
+			value, cvcutil.MigrateReplicaAnnotation)
+	}
+	if pools[0] == pools[1] {
+		return "", "", errors.Errorf("replica can't be migrated from pool %s to itself", pools[0])
+	}
+	return pools[0], pools[1], nil
+}
+
+// requestMigration sets the migrate annotation on CVC to migrate the volume
+// replica from one pool to another
+func (c *CVCController) requestMigration(cvc *apis.CStorVolumeConfig,
+	fromPool, toPool string) (*apis.CStorVolumeConfig, error) {
+	newCVC := cvc.DeepCopy()
+	if newCVC.Annotations == nil {
+		newCVC.Annotations = map[string]string{}
+	}
+	newCVC.Annotations[cvcutil.MigrateReplicaAnnotation] = fromPool + ":" + toPool
+	newCVC, err := c.clientset.CstorV1().CStorVolumeConfigs(cvc.Namespace).
+		Update(context.TODO(), newCVC, metav1.UpdateOptions{})
+	if err != nil {
+		return cvc, errors.Wrapf(err, "failed to request migration of cvc %s replica from pool %s to pool %s",
+			cvc.Name, fromPool, toPool)
+	}
+	return newCVC, nil
+}
+
+// migrateReplica moves the volume replica from one pool to another without
+// losing the redundancy of the volume. Replica pools of the volume can't be
+// renamed hence the migration is performed in following steps, one step per
+// sync of CVC:
+//  1. Add the new pool to the replica pools of CVC, the replica on the new
+//     pool is created by scaling up the volume replicas.
+//  2. Wait until the new replica is rebuilt from the other replicas and is
+//     Healthy.
+//  3. Remove the old pool from the replica pools of CVC, the replica on the
+//     old pool is removed by scaling down the volume replicas.
+//  4. Once the replica on the old pool is removed, remove the annotation and
+//     the migrate conditions from CVC.
+//
+// Progress of the migration is reported in the MigratingReplica condition of
+// CVC. It returns the updated CVC so that the scaling of the replicas is
+// processed in the same sync.
+func (c *CVCController) migrateReplica(cvc *apis.CStorVolumeConfig) (*apis.CStorVolumeConfig, error) {
+	value := cvc.GetAnnotations()[cvcutil.MigrateReplicaAnnotation]
+	if value == "" {
+		// annotation is removed by the user to cancel the migration, the
+		// replica pools are left as they are
+		newCVC := cvc.DeepCopy()
+		newCVC.Status.Conditions = mergeMigrateConditionsOfCVC(newCVC.Status.Conditions, nil)
+		return c.PatchCVCStatus(cvc, newCVC)
+	}
+	fromPool, toPool, err := parseMigrateReplica(value)
+	if err != nil {
+		return c.failMigrate(cvc, err)
+	}
+
+	desiredPoolNames := cvc.GetDesiredReplicaPoolNames()
+	currentPoolNames := cvc.Status.PoolInfo
+	fromDesired := util.ContainsString(desiredPoolNames, fromPool)
+	fromCurrent := util.ContainsString(currentPoolNames, fromPool)
+	toDesired := util.ContainsString(desiredPoolNames, toPool)
+	toCurrent := util.ContainsString(currentPoolNames, toPool)
+
+	switch {
+	case !fromDesired && !fromCurrent && toDesired && toCurrent:
+		return c.markCVCMigrateFinished(cvc, fromPool, toPool)
+	case fromDesired && fromCurrent && !toDesired && !toCurrent:
+		return c.addMigrationReplica(cvc, fromPool, toPool)
+	case fromDesired && toDesired && !toCurrent:
+		return c.setMigrateCondition(cvc, cvcutil.CStorVolumeConfigMigrating,
+			fmt.Sprintf("adding replica of volume %s on pool %s", cvc.Name, toPool))
+	case fromDesired && toDesired && toCurrent && hasCondition(cvc, cvcutil.CStorVolumeConfigMigrating):
+		return c.removeMigratedReplica(cvc, fromPool, toPool)
+	case !fromDesired && fromCurrent && toDesired:
+		return c.setMigrateCondition(cvc, cvcutil.CStorVolumeConfigMigrating,
+			fmt.Sprintf("removing replica of volume %s from pool %s", cvc.Name, fromPool))
+	case !fromDesired:
+		return c.failMigrate(cvc, errors.Errorf("volume %s has no replica on pool %s", cvc.Name, fromPool))
+	default:
+		return c.failMigrate(cvc, errors.Errorf("volume %s already has a replica on pool %s", cvc.Name, toPool))
+	}
+}
+
+// addMigrationReplica adds the pool to migrate to into the replica pools of
+// CVC once the pool is verified to be an online pool of the CSPC of volume
+func (c *CVCController) addMigrationReplica(cvc *apis.CStorVolumeConfig,
+	fromPool, toPool string) (*apis.CStorVolumeConfig, error) {
+	if len(cvc.Spec.Policy.ReplicaPoolInfo) != len(cvc.Status.PoolInfo) {
+		// migration starts once the ongoing scaling of replicas is finished
+		return c.setMigrateCondition(cvc, cvcutil.CStorVolumeConfigMigrating,
+			fmt.Sprintf("waiting for scaling of volume %s replicas to finish", cvc.Name))
+	}
+	cspi, err := c.clientset.CstorV1().CStorPoolInstances(openebsNamespace).
+		Get(context.TODO(), toPool, metav1.GetOptions{})
+	if k8serror.IsNotFound(err) {
+		return c.failMigrate(cvc, errors.Errorf("pool %s doesn't exist", toPool))
+	}
+	if err != nil {
+		return cvc, errors.Wrapf(err, "failed to get cstorpoolinstance %s", toPool)
+	}
+	if cspcName := getCSPC(cvc); cspi.GetLabels()[string(types.CStorPoolClusterLabelKey)] != cspcName {
+		return c.failMigrate(cvc, errors.Errorf("pool %s doesn't belong to cstorpoolcluster %s of volume %s",
+			toPool, cspcName, cvc.Name))
+	}
+	if cspiutil.IsDrainRequested(cspi) {
+		return c.failMigrate(cvc, errors.Errorf("pool %s is being drained", toPool))
+	}
+	if cspiutil.IsCordoned(cspi) {
+		return c.failMigrate(cvc, errors.Errorf("pool %s is cordoned", toPool))
+	}
+	if string(cspi.Status.Phase) != cspiOnline {
+		return c.failMigrate(cvc, errors.Errorf("pool %s is %s, replica can be migrated only to %s pool",
+			toPool, cspi.Status.Phase, cspiOnline))
+	}
+
+	newCVC := cvc.DeepCopy()
+	newCVC.Spec.Policy.ReplicaPoolInfo = append(newCVC.Spec.Policy.ReplicaPoolInfo,
+		apis.ReplicaPoolInfo{PoolName: toPool})
+	newCVC.Status.Conditions = mergeMigrateConditionsOfCVC(newCVC.Status.Conditions,
+		[]apis.CStorVolumeConfigCondition{newMigrateCondition(cvcutil.CStorVolumeConfigMigrating,
+			fmt.Sprintf("adding replica of volume %s on pool %s", cvc.Name, toPool))})
+	newCVC, err = c.clientset.CstorV1().CStorVolumeConfigs(cvc.Namespace).
+		Update(context.TODO(), newCVC, metav1.UpdateOptions{})
+	if err != nil {
+		return cvc, errors.Wrapf(err, "failed to add pool %s to replica pools of cvc %s", toPool, cvc.Name)
+	}
+	klog.Infof("Migrating replica of volume %s from pool %s to %s", cvc.Name, fromPool, toPool)
+	c.recorder.Eventf(cvc, corev1.EventTypeNormal, string(cvcutil.CStorVolumeConfigMigrating),
+		"Migrating replica from pool %s to %s", fromPool, toPool)
+	return newCVC, nil
+}
+
+// removeMigratedReplica removes the pool to migrate from out of the replica
+// pools of CVC once the replica on the pool to migrate to is Healthy
+func (c *CVCController) removeMigratedReplica(cvc *apis.CStorVolumeConfig,
+	fromPool, toPool string) (*apis.CStorVolumeConfig, error) {
+	cvrName := cvc.Name + "-" + toPool
+	cvr, err := c.clientset.CstorV1().CStorVolumeReplicas(openebsNamespace).
+		Get(context.TODO(), cvrName, metav1.GetOptions{})
+	if err != nil {
+		return cvc, errors.Wrapf(err, "failed to get cstorvolumereplica %s", cvrName)
+	}
+	if cvr.Status.Phase != apis.CVRStatusOnline {
+		available := len(cvr.Status.Snapshots)
+		total := available + len(cvr.Status.PendingSnapshots)
+		return c.setMigrateCondition(cvc, cvcutil.CStorVolumeConfigMigrating,
+			fmt.Sprintf("waiting for replica %s to be %s, replica is %s with %d of %d snapshots rebuilt",
+				cvrName, apis.CVRStatusOnline, cvr.Status.Phase, available, total))
+	}
+
+	newCVC := cvc.DeepCopy()
+	replicaPools := []apis.ReplicaPoolInfo{}
+	for _, info := range newCVC.Spec.Policy.ReplicaPoolInfo {
+		if info.PoolName != fromPool {
+			replicaPools = append(replicaPools, info)
+		}
+	}
+	newCVC.Spec.Policy.ReplicaPoolInfo = replicaPools
+	newCVC.Status.Conditions = mergeMigrateConditionsOfCVC(newCVC.Status.Conditions,
+		[]apis.CStorVolumeConfigCondition{newMigrateCondition(cvcutil.CStorVolumeConfigMigrating,
+			fmt.Sprintf("removing replica of volume %s from pool %s", cvc.Name, fromPool))})
+	newCVC, err = c.clientset.CstorV1().CStorVolumeConfigs(cvc.Namespace).
+		Update(context.TODO(), newCVC, metav1.UpdateOptions{})
+	if err != nil {
+		return cvc, errors.Wrapf(err, "failed to remove pool %s from replica pools of cvc %s", fromPool, cvc.Name)
+	}
+	klog.Infof("Replica %s of volume %s is %s, removing replica from pool %s",
+		cvrName, cvc.Name, apis.CVRStatusOnline, fromPool)
+	return newCVC, nil
+}
+
+// markCVCMigrateFinished removes the migrate annotation and conditions from
+// CVC
+func (c *CVCController) markCVCMigrateFinished(cvc *apis.CStorVolumeConfig,
+	fromPool, toPool string) (*apis.CStorVolumeConfig, error) {
+	newCVC := cvc.DeepCopy()
+	delete(newCVC.Annotations, cvcutil.MigrateReplicaAnnotation)
+	newCVC.Status.Conditions = mergeMigrateConditionsOfCVC(newCVC.Status.Conditions, nil)
+	newCVC, err := c.clientset.CstorV1().CStorVolumeConfigs(cvc.Namespace).
+		Update(context.TODO(), newCVC, metav1.UpdateOptions{})
+	if err != nil {
+		return cvc, errors.Wrapf(err, "failed to mark cvc %s as migrated", cvc.Name)
+	}
+	klog.Infof("Migration of volume %s replica from pool %s to %s finished", cvc.Name, fromPool, toPool)
+	c.recorder.Eventf(cvc, corev1.EventTypeNormal, CStorVolumeConfigMigrateSuccess,
+		"Migrated replica from pool %s to %s", fromPool, toPool)
+	return newCVC, nil
+}
+
+// failMigrate records the reason migration can't be performed on CVC, the
+// migration is retried once the annotation is corrected
+func (c *CVCController) failMigrate(cvc *apis.CStorVolumeConfig, err error) (*apis.CStorVolumeConfig, error) {
+	if cond := getCondition(cvc, cvcutil.CStorVolumeConfigMigrateFailed); cond == nil || cond.Message != err.Error() {
+		c.recorder.Event(cvc, corev1.EventTypeWarning, string(cvcutil.CStorVolumeConfigMigrateFailed), err.Error())
+	}
+	return c.setMigrateCondition(cvc, cvcutil.CStorVolumeConfigMigrateFailed, err.Error())
+}
+
+// setMigrateCondition replaces the migrate conditions of CVC with the given
+// condition
+func (c *CVCController) setMigrateCondition(cvc *apis.CStorVolumeConfig,
+	condType apis.CStorVolumeConfigConditionType, message string) (*apis.CStorVolumeConfig, error) {
+	if cond := getCondition(cvc, condType); cond != nil && cond.Message == message {
+		return cvc, nil
+	}
+	newCVC := cvc.DeepCopy()
+	newCVC.Status.Conditions = mergeMigrateConditionsOfCVC(newCVC.Status.Conditions,
+		[]apis.CStorVolumeConfigCondition{newMigrateCondition(condType, message)})
+	return c.PatchCVCStatus(cvc, newCVC)
+}
+
+func newMigrateCondition(condType apis.CStorVolumeConfigConditionType,
+	message string) apis.CStorVolumeConfigCondition {
+	return apis.CStorVolumeConfigCondition{
+		Type:               condType,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(condType),
+		Message:            message,
+	}
+}
+
+// mergeMigrateConditionsOfCVC replaces the migrate conditions with the given
+// conditions leaving other conditions untouched
+func mergeMigrateConditionsOfCVC(oldConditions,
+	migrateConditions []apis.CStorVolumeConfigCondition) []apis.CStorVolumeConfigCondition {
+	newConditions := []apis.CStorVolumeConfigCondition{}
+	for _, condition := range oldConditions {
+		if !knownMigrateConditions[condition.Type] {
+			newConditions = append(newConditions, condition)
+		}
+	}
+	return append(newConditions, migrateConditions...)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	apistypes "github.com/openebs/api/v3/pkg/apis/types"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newMigrateCSPI(name, cspcName string, phase apis.CStorPoolInstancePhase) *apis.CStorPoolInstance {
+	cspi := &apis.CStorPoolInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{apistypes.CStorPoolClusterLabelKey: cspcName},
+		},
+	}
+	cspi.Status.Phase = phase
+	return cspi
+}
+
+func newMigrateCVC(annotation string, pools ...string) *apis.CStorVolumeConfig {
+	cvc := &apis.CStorVolumeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pvc-1",
+			Namespace:   namespace,
+			Labels:      map[string]string{apistypes.CStorPoolClusterLabelKey: "cspc"},
+			Annotations: map[string]string{cvcutil.MigrateReplicaAnnotation: annotation},
+		},
+		Status: apis.CStorVolumeConfigStatus{Phase: apis.CStorVolumeConfigPhaseBound, PoolInfo: pools},
+	}
+	for _, pool := range pools {
+		cvc.Spec.Policy.ReplicaPoolInfo = append(cvc.Spec.Policy.ReplicaPoolInfo,
+			apis.ReplicaPoolInfo{PoolName: pool})
+	}
+	return cvc
+}
+
+func TestMigrateReplica(t *testing.T) {
+	openebsNamespace = namespace
+	f := newFixture(t)
+	f.openebsObjects = append(f.openebsObjects,
+		newMigrateCVC("cspi-1:cspi-3", "cspi-1", "cspi-2"),
+		newMigrateCSPI("cspi-3", "cspc", apis.CStorPoolStatusOnline),
+		&apis.CStorVolumeReplica{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc-1-cspi-3", Namespace: namespace},
+			Status: apis.CStorVolumeReplicaStatus{
+				Phase:            apis.CVRStatusRebuilding,
+				Snapshots:        map[string]apis.CStorSnapshotInfo{"s1": {}},
+				PendingSnapshots: map[string]apis.CStorSnapshotInfo{"s2": {}},
+			},
+		},
+	)
+	f.SetFakeClient()
+	c, _, _, _ := f.newCVCController()
+	cvcs := f.openebsClient.CstorV1().CStorVolumeConfigs(namespace)
+
+	// migrate runs a step and the pool info of status is updated as per the
+	// spec as done on scaling of the replicas
+	step := func(scaled bool) *apis.CStorVolumeConfig {
+		cvc, err := cvcs.Get(context.TODO(), "pvc-1", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !c.isMigratePending(cvc) {
+			return cvc
+		}
+		cvc, err = c.migrateReplica(cvc)
+		if err != nil {
+			t.Fatalf("unexpected error migrating replica: %v", err)
+		}
+		if scaled {
+			cvc.Status.PoolInfo = cvc.GetDesiredReplicaPoolNames()
+			if cvc, err = cvcs.Update(context.TODO(), cvc, metav1.UpdateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return cvc
+	}
+	expectPools := func(cvc *apis.CStorVolumeConfig, expected string, expectedCondition string) {
+		t.Helper()
+		if got := strings.Join(cvc.GetDesiredReplicaPoolNames(), ","); got != expected {
+			t.Errorf("expected replica pools %s but got %s", expected, got)
+		}
+		cond := getCondition(cvc, cvcutil.CStorVolumeConfigMigrating)
+		switch {
+		case expectedCondition == "" && cond != nil:
+			t.Errorf("expected no migrating condition but got %+v", cond)
+		case expectedCondition != "" && (cond == nil || !strings.Contains(cond.Message, expectedCondition)):
+			t.Errorf("expected migrating condition %q but got %+v", expectedCondition, cond)
+		}
+	}
+
+	expectPools(step(false), "cspi-1,cspi-2,cspi-3", "adding replica of volume pvc-1 on pool cspi-3")
+	expectPools(step(true), "cspi-1,cspi-2,cspi-3", "adding replica")
+	// replica on new pool is being rebuilt
+	expectPools(step(false), "cspi-1,cspi-2,cspi-3", "replica is Rebuilding with 1 of 2 snapshots rebuilt")
+
+	cvr, _ := f.openebsClient.CstorV1().CStorVolumeReplicas(namespace).Get(context.TODO(), "pvc-1-cspi-3", metav1.GetOptions{})
+	cvr.Status.Phase = apis.CVRStatusOnline
+	_, _ = f.openebsClient.CstorV1().CStorVolumeReplicas(namespace).Update(context.TODO(), cvr, metav1.UpdateOptions{})
+	expectPools(step(true), "cspi-2,cspi-3", "removing replica of volume pvc-1 from pool cspi-1")
+
+	cvc := step(false)
+	expectPools(cvc, "cspi-2,cspi-3", "")
+	if _, ok := cvc.Annotations[cvcutil.MigrateReplicaAnnotation]; ok || len(cvc.Status.Conditions) != 0 {
+		t.Errorf("expected migrate annotation and conditions to be removed but got %+v", cvc)
+	}
+}
+
+func TestMigrateReplicaFailure(t *testing.T) {
+	openebsNamespace = namespace
+	cordoned := newMigrateCSPI("cspi-6", "cspc", apis.CStorPoolStatusOnline)
+	cordoned.Annotations = map[string]string{cspiutil.CordonAnnotation: "true"}
+	tests := map[string]struct {
+		cvc               *apis.CStorVolumeConfig
+		expectedErrSubstr string
+	}{
+		"invalid annotation":     {newMigrateCVC("cspi-1", "cspi-1"), "expected <from-pool>:<to-pool>"},
+		"migrate to itself":      {newMigrateCVC("cspi-1:cspi-1", "cspi-1"), "to itself"},
+		"no replica on pool":     {newMigrateCVC("cspi-9:cspi-3", "cspi-1"), "no replica on pool cspi-9"},
+		"replica already exists": {newMigrateCVC("cspi-1:cspi-2", "cspi-1", "cspi-2"), "already has a replica"},
+		"pool doesn't exist":     {newMigrateCVC("cspi-1:cspi-9", "cspi-1"), "doesn't exist"},
+		"pool of other cspc":     {newMigrateCVC("cspi-1:cspi-4", "cspi-1"), "doesn't belong"},
+		"pool is offline":        {newMigrateCVC("cspi-1:cspi-5", "cspi-1"), "is OFFLINE"},
+		"pool is cordoned":       {newMigrateCVC("cspi-1:cspi-6", "cspi-1"), "is cordoned"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t)
+			f.openebsObjects = append(f.openebsObjects, test.cvc,
+				newMigrateCSPI("cspi-4", "other-cspc", apis.CStorPoolStatusOnline),
+				newMigrateCSPI("cspi-5", "cspc", apis.CStorPoolStatusOffline),
+				cordoned)
+			f.SetFakeClient()
+			c, _, _, _ := f.newCVCController()
+
+			cvc, err := c.migrateReplica(test.cvc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			cond := getCondition(cvc, cvcutil.CStorVolumeConfigMigrateFailed)
+			if cond == nil || !strings.Contains(cond.Message, test.expectedErrSubstr) {
+				t.Errorf("expected failed condition containing %q but got %+v", test.expectedErrSubstr, cond)
+			}
+			if len(cvc.Spec.Policy.ReplicaPoolInfo) != len(test.cvc.Spec.Policy.ReplicaPoolInfo) {
+				t.Errorf("expected replica pools to be unchanged but got %+v", cvc.Spec.Policy.ReplicaPoolInfo)
+			}
+
+			// removing the annotation cancels the migration
+			delete(cvc.Annotations, cvcutil.MigrateReplicaAnnotation)
+			if !c.isMigratePending(cvc) {
+				t.Fatalf("expected conditions of cancelled migration to be pending")
+			}
+			if cvc, err = c.migrateReplica(cvc); err != nil || len(cvc.Status.Conditions) != 0 {
+				t.Errorf("expected conditions to be removed on cancel but got %+v, %v", cvc.Status.Conditions, err)
+			}
+		})
+	}
+}
@@ -18,62 +18,225 @@ package cstorvolumeconfig
 
 import (
 	"context"
-	"fmt"
+	"reflect"
+	"sort"
+	"time"
 
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/pkg/errors"
 	policy "k8s.io/api/policy/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
 )
 
-// GetPDBPoolLabels returns the pool labels from poolNames
-func GetPDBPoolLabels(poolNames []string) map[string]string {
-	pdbLabels := map[string]string{}
-	for _, poolName := range poolNames {
-		key := fmt.Sprintf("openebs.io/%s", poolName)
-		pdbLabels[key] = "true"
-	}
-	return pdbLabels
+const (
+	// maintenanceAnnotation is the annotation on CSPC holding the time in
+	// RFC3339 format until which pool pods of the CSPC can be disrupted one
+	// at a time irrespective of the health of the volume replicas
+	maintenanceAnnotation = "cstorpoolcluster.openebs.io/maintenance-until"
+
+	// poolPDBSyncInterval is the interval at which PDBs of the pools are
+	// synced as per the health of the volume replicas
+	poolPDBSyncInterval = 30 * time.Second
+)
+
+// getPoolPDBName returns the name of the PDB of pool pods of CSPC
+func getPoolPDBName(cspcName string) string {
+	return cspcName + "-pool-pdb"
 }
 
-// GetPDBLabels returns the labels required for building PDB based on arguments
-func GetPDBLabels(poolNames []string, cspcName string) map[string]string {
-	pdbLabels := GetPDBPoolLabels(poolNames)
-	pdbLabels[string(types.CStorPoolClusterLabelKey)] = cspcName
-	return pdbLabels
+// runPoolPDBSync syncs the PDBs of pools of every CSPC once per
+// poolPDBSyncInterval until stopCh is closed
+func (c *CVCController) runPoolPDBSync(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		cspcList, err := c.clientset.CstorV1().CStorPoolClusters(openebsNamespace).
+			List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			klog.Errorf("failed to list cstorpoolclusters to sync PDBs: %v", err)
+			return
+		}
+		for _, cspc := range cspcList.Items {
+			if err := c.syncPoolPDB(cspc.Name); err != nil {
+				klog.Errorf("failed to sync PDB of cstorpoolcluster %s: %v", cspc.Name, err)
+			}
+		}
+	}, poolPDBSyncInterval, stopCh)
 }
 
-// GetPDBLabelSelector returns the labelSelector to list the PDB
-func GetPDBLabelSelector(poolNames []string) string {
-	var labelSelector string
-	pdbLabels := GetPDBPoolLabels(poolNames)
+// syncPoolPDB creates, updates or deletes the PDB of pool pods of CSPC. Pools
+// holding a replica of a HA volume are covered by a single PDB per CSPC whose
+// maxUnavailable is the least number of replicas any of those volumes can
+// lose without losing quorum, as computed by poolDisruptionBudget. While the
+// CSPC is under maintenance, pool pods can be disrupted one at a time even if
+// a volume can't lose a replica. PDBs created per set of replica pools by
+// earlier versions are deleted, and HA volumes are labelled with the PDB of
+// their CSPC.
+func (c *CVCController) syncPoolPDB(cspcName string) error {
+	cvcList, err := c.clientset.CstorV1().CStorVolumeConfigs(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: types.CStorPoolClusterLabelKey + "=" + cspcName,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cvc of cspc %s", cspcName)
+	}
+	cvrs, err := c.cvrLister.CStorVolumeReplicas(openebsNamespace).List(labels.Everything())
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cvr")
+	}
+	healthyReplicas := map[string]int{}
+	for _, cvr := range cvrs {
+		if cvr.Status.Phase == apis.CVRStatusOnline {
+			healthyReplicas[cvr.Labels[string(types.PersistentVolumeLabelKey)]]++
+		}
+	}
+	maxUnavailable, pools := poolDisruptionBudget(cvcList.Items, healthyReplicas)
+
+	pdbName := getPoolPDBName(cspcName)
+	pdbClient := c.kubeclientset.PolicyV1().PodDisruptionBudgets(openebsNamespace)
+	pdbList, err := pdbClient.List(context.TODO(), metav1.ListOptions{
+		LabelSelector: types.CStorPoolClusterLabelKey + "=" + cspcName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list PDB of cspc %s", cspcName)
+	}
+	var pdb *policy.PodDisruptionBudget
+	for i := range pdbList.Items {
+		if pdbList.Items[i].Name == pdbName && len(pools) != 0 {
+			pdb = &pdbList.Items[i]
+			continue
+		}
+		err = pdbClient.Delete(context.TODO(), pdbList.Items[i].Name, metav1.DeleteOptions{})
+		if err != nil && !k8serror.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete PDB %s", pdbList.Items[i].Name)
+		}
+		klog.Infof("Deleted PDB %s of cspc %s", pdbList.Items[i].Name, cspcName)
+	}
+	if len(pools) == 0 {
+		return nil
+	}
+
+	if maxUnavailable < 1 {
+		underMaintenance, err := c.isUnderMaintenance(cspcName)
+		if err != nil {
+			return err
+		}
+		if underMaintenance {
+			klog.V(2).Infof("cspc %s is under maintenance, allowing disruption of a pool", cspcName)
+			maxUnavailable = 1
+		}
+	}
+	desiredPDB := buildPoolPDB(cspcName, maxUnavailable, pools)
+	if pdb == nil {
+		_, err = pdbClient.Create(context.TODO(), desiredPDB, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create PDB %s", pdbName)
+		}
+		klog.Infof("Created PDB %s with maxUnavailable %d for pools %v", pdbName, maxUnavailable, pools)
+	} else if !reflect.DeepEqual(pdb.Spec, desiredPDB.Spec) {
+		pdb.Spec = desiredPDB.Spec
+		_, err = pdbClient.Update(context.TODO(), pdb, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to update PDB %s", pdbName)
+		}
+		klog.Infof("Updated PDB %s with maxUnavailable %d for pools %v", pdbName, maxUnavailable, pools)
+	}
+	return c.labelCVCsWithPDB(cvcList.Items, pdbName)
+}
 
-	for key, value := range pdbLabels {
-		labelSelector = labelSelector + key + "=" + value + ","
+// poolDisruptionBudget returns the replica pools of the bound HA volumes and
+// the number of those pools that can be disrupted at a time. A volume can
+// lose all but quorum, i.e. (replicas/2)+1, of its healthy replicas, hence the
+// least number of healthy replicas above quorum among the volumes is returned.
+func poolDisruptionBudget(cvcs []apis.CStorVolumeConfig, healthyReplicas map[string]int) (int, []string) {
+	maxUnavailable := -1
+	poolSet := map[string]bool{}
+	for _, cvc := range cvcs {
+		if cvc.DeletionTimestamp != nil ||
+			cvc.Status.Phase != apis.CStorVolumeConfigPhaseBound ||
+			!isHAVolume(&cvc) {
+			continue
+		}
+		quorum := len(cvc.Status.PoolInfo)/2 + 1
+		tolerance := healthyReplicas[cvc.Name] - quorum
+		if tolerance < 0 {
+			tolerance = 0
+		}
+		if maxUnavailable < 0 || tolerance < maxUnavailable {
+			maxUnavailable = tolerance
+		}
+		for _, poolName := range cvc.Status.PoolInfo {
+			poolSet[poolName] = true
+		}
+	}
+	pools := []string{}
+	for poolName := range poolSet {
+		pools = append(pools, poolName)
 	}
-	return labelSelector[:len(labelSelector)-1]
+	sort.Strings(pools)
+	return maxUnavailable, pools
 }
 
-// createPDB creates PDB for cStorVolumes based on arguments
-func (c *CVCController) createPDB(poolNames []string, cspcName string) (*policy.PodDisruptionBudget, error) {
-	// Calculate minAvailable value from cStorVolume replica count
-	//minAvailable := (cvObj.Spec.ReplicationFactor >> 1) + 1
-	maxUnavailableIntStr := intstr.FromInt(1)
+// isUnderMaintenance returns true if the maintenance time set on CSPC hasn't
+// passed yet
+func (c *CVCController) isUnderMaintenance(cspcName string) (bool, error) {
+	cspc, err := c.clientset.CstorV1().CStorPoolClusters(openebsNamespace).
+		Get(context.TODO(), cspcName, metav1.GetOptions{})
+	if err != nil {
+		if k8serror.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get cspc %s", cspcName)
+	}
+	value := cspc.GetAnnotations()[maintenanceAnnotation]
+	if value == "" {
+		return false, nil
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.Errorf("invalid annotation %s=%s on cspc %s, expected time in RFC3339 format",
+			maintenanceAnnotation, value, cspcName)
+		return false, nil
+	}
+	return time.Now().Before(until), nil
+}
 
-	//build podDisruptionBudget for volume
-	pdbObj := &policy.PodDisruptionBudget{
+// buildPoolPDB returns the PDB of the pool pods of CSPC
+func buildPoolPDB(cspcName string, maxUnavailable int, pools []string) *policy.PodDisruptionBudget {
+	maxUnavailableIntStr := intstr.FromInt(maxUnavailable)
+	return &policy.PodDisruptionBudget{
 		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: cspcName,
-			Labels:       GetPDBLabels(poolNames, cspcName),
+			Name:   getPoolPDBName(cspcName),
+			Labels: map[string]string{types.CStorPoolClusterLabelKey: cspcName},
 		},
 		Spec: policy.PodDisruptionBudgetSpec{
 			MaxUnavailable: &maxUnavailableIntStr,
-			Selector:       getPDBSelector(poolNames),
+			Selector:       getPDBSelector(pools),
 		},
 	}
-	// Create podDisruptionBudget
-	return c.kubeclientset.PolicyV1().PodDisruptionBudgets(openebsNamespace).
-		Create(context.TODO(), pdbObj, metav1.CreateOptions{})
+}
+
+// labelCVCsWithPDB labels the bound HA volumes with the PDB of their pools
+func (c *CVCController) labelCVCsWithPDB(cvcs []apis.CStorVolumeConfig, pdbName string) error {
+	for i := range cvcs {
+		cvc := &cvcs[i]
+		if cvc.DeletionTimestamp != nil ||
+			cvc.Status.Phase != apis.CStorVolumeConfigPhaseBound ||
+			!isHAVolume(cvc) || getPDBName(cvc) == pdbName {
+			continue
+		}
+		cvc.Labels[types.PodDisruptionBudgetKey] = pdbName
+		_, err := c.clientset.CstorV1().CStorVolumeConfigs(cvc.Namespace).
+			Update(context.TODO(), cvc, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to label cvc %s with PDB %s", cvc.Name, pdbName)
+		}
+	}
+	return nil
 }
 
 // getPDBSelector returns PDB label selector from list of pools
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	apistypes "github.com/openebs/api/v3/pkg/apis/types"
+	policy "k8s.io/api/policy/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPDBCVR(volume, pool string, phase apis.CStorVolumeReplicaPhase) *apis.CStorVolumeReplica {
+	return &apis.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volume + "-" + pool,
+			Namespace: namespace,
+			Labels:    map[string]string{apistypes.PersistentVolumeLabelKey: volume},
+		},
+		Status: apis.CStorVolumeReplicaStatus{Phase: phase},
+	}
+}
+
+func TestPoolDisruptionBudget(t *testing.T) {
+	deleted := newRebalanceCVC("pvc-4", "1Gi", "cspi-1", "cspi-2", "cspi-6")
+	now := metav1.Now()
+	deleted.DeletionTimestamp = &now
+	tests := map[string]struct {
+		healthyReplicas        map[string]int
+		expectedMaxUnavailable int
+	}{
+		"all replicas are healthy":        {map[string]int{"pvc-1": 3, "pvc-2": 5}, 1},
+		"a replica of volume is degraded": {map[string]int{"pvc-1": 2, "pvc-2": 5}, 0},
+		"replicas of 5 replica volume":    {map[string]int{"pvc-1": 3, "pvc-2": 4}, 1},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cvcs := []apis.CStorVolumeConfig{
+				*newRebalanceCVC("pvc-1", "1Gi", "cspi-1", "cspi-2", "cspi-3"),
+				*newRebalanceCVC("pvc-2", "1Gi", "cspi-1", "cspi-2", "cspi-3", "cspi-4", "cspi-5"),
+				// non HA and deleted volumes don't contribute to the budget
+				*newRebalanceCVC("pvc-3", "1Gi", "cspi-7"),
+				*deleted,
+			}
+			maxUnavailable, pools := poolDisruptionBudget(cvcs, test.healthyReplicas)
+			if maxUnavailable != test.expectedMaxUnavailable {
+				t.Errorf("expected maxUnavailable %d but got %d", test.expectedMaxUnavailable, maxUnavailable)
+			}
+			if got := strings.Join(pools, ","); got != "cspi-1,cspi-2,cspi-3,cspi-4,cspi-5" {
+				t.Errorf("expected pools cspi-1 to cspi-5 but got %s", got)
+			}
+		})
+	}
+}
+
+func TestSyncPoolPDB(t *testing.T) {
+	openebsNamespace = namespace
+	f := newFixture(t)
+	cspc := &apis.CStorPoolCluster{ObjectMeta: metav1.ObjectMeta{Name: "cspc", Namespace: namespace}}
+	f.openebsObjects = append(f.openebsObjects, cspc,
+		newRebalanceCVC("pvc-1", "1Gi", "cspi-1", "cspi-2", "cspi-3"),
+		newRebalanceCVC("pvc-2", "1Gi", "cspi-4"),
+	)
+	f.cvrLister = append(f.cvrLister,
+		newPDBCVR("pvc-1", "cspi-1", apis.CVRStatusOnline),
+		newPDBCVR("pvc-1", "cspi-2", apis.CVRStatusOnline),
+		newPDBCVR("pvc-1", "cspi-3", apis.CVRStatusOnline),
+	)
+	// PDB created per set of replica pools by earlier versions
+	f.k8sObjects = append(f.k8sObjects, &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cspcxk2z9",
+			Namespace: namespace,
+			Labels:    map[string]string{apistypes.CStorPoolClusterLabelKey: "cspc"},
+		},
+	})
+	f.SetFakeClient()
+	c, informers, _, _ := f.newCVCController()
+	pdbs := f.k8sClient.PolicyV1().PodDisruptionBudgets(namespace)
+	expectPDB := func(maxUnavailable int) {
+		t.Helper()
+		if err := c.syncPoolPDB("cspc"); err != nil {
+			t.Fatalf("unexpected error syncing PDB: %v", err)
+		}
+		pdbList, _ := pdbs.List(context.TODO(), metav1.ListOptions{})
+		if len(pdbList.Items) != 1 || pdbList.Items[0].Name != getPoolPDBName("cspc") {
+			t.Fatalf("expected only PDB %s but got %+v", getPoolPDBName("cspc"), pdbList.Items)
+		}
+		pdb := pdbList.Items[0]
+		if pdb.Spec.MaxUnavailable.IntValue() != maxUnavailable {
+			t.Errorf("expected maxUnavailable %d but got %s", maxUnavailable, pdb.Spec.MaxUnavailable.String())
+		}
+		if got := strings.Join(pdb.Spec.Selector.MatchExpressions[0].Values, ","); got != "cspi-1,cspi-2,cspi-3" {
+			t.Errorf("expected PDB of pools cspi-1,cspi-2,cspi-3 but got %s", got)
+		}
+	}
+
+	expectPDB(1)
+	cvc, _ := f.openebsClient.CstorV1().CStorVolumeConfigs(namespace).Get(context.TODO(), "pvc-1", metav1.GetOptions{})
+	if getPDBName(cvc) != getPoolPDBName("cspc") {
+		t.Errorf("expected cvc to be labelled with PDB %s but got %q", getPoolPDBName("cspc"), getPDBName(cvc))
+	}
+
+	// volume can't lose another replica while a replica is degraded
+	cvrIndexer := informers.Cstor().V1().CStorVolumeReplicas().Informer().GetIndexer()
+	_ = cvrIndexer.Update(newPDBCVR("pvc-1", "cspi-3", apis.CVRStatusDegraded))
+	expectPDB(0)
+
+	// maintenance allows disruption of a pool at a time till it's over
+	cspc.Annotations = map[string]string{maintenanceAnnotation: time.Now().Add(time.Hour).Format(time.RFC3339)}
+	_, _ = f.openebsClient.CstorV1().CStorPoolClusters(namespace).Update(context.TODO(), cspc, metav1.UpdateOptions{})
+	expectPDB(1)
+	cspc.Annotations[maintenanceAnnotation] = time.Now().Add(-time.Hour).Format(time.RFC3339)
+	_, _ = f.openebsClient.CstorV1().CStorPoolClusters(namespace).Update(context.TODO(), cspc, metav1.UpdateOptions{})
+	expectPDB(0)
+
+	// PDB is deleted once no HA volume is left on the pools
+	_ = f.openebsClient.CstorV1().CStorVolumeConfigs(namespace).Delete(context.TODO(), "pvc-1", metav1.DeleteOptions{})
+	if err := c.syncPoolPDB("cspc"); err != nil {
+		t.Fatalf("unexpected error syncing PDB: %v", err)
+	}
+	if _, err := pdbs.Get(context.TODO(), getPoolPDBName("cspc"), metav1.GetOptions{}); !k8serror.IsNotFound(err) {
+		t.Errorf("expected PDB to be deleted but got %v", err)
+	}
+}
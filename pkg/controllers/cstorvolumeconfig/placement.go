@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// TargetPlacementKey is the annotation on CStorVolumePolicy and CVC to
+	// choose the built-in placement mode of the target pod
+	TargetPlacementKey = "cstor.openebs.io/target-placement"
+	// TargetPlacementApp prefers the node where the volume is published i.e
+	// the node running the application pod
+	TargetPlacementApp = "app"
+	// TargetPlacementReplicaAntiAffinity prefers the nodes which don't host
+	// the pools of volume replicas so that a node failure doesn't take out
+	// the target along with a replica
+	TargetPlacementReplicaAntiAffinity = "replica-anti-affinity"
+
+	// hostNameLabelKey is the node label holding the hostname of the node
+	hostNameLabelKey = "kubernetes.io/hostname"
+	// nodeNameFieldKey is the node field holding the name of the node
+	nodeNameFieldKey = "metadata.name"
+	// targetPlacementWeight is the weight of the placement preference
+	targetPlacementWeight int32 = 100
+)
+
+// getTargetPlacement returns the target placement mode requested via the
+// annotation on given object
+func getTargetPlacement(obj metav1.Object) string {
+	return obj.GetAnnotations()[TargetPlacementKey]
+}
+
+// isValidTargetPlacement returns true if the placement mode is known
+func isValidTargetPlacement(placement string) bool {
+	return placement == TargetPlacementApp ||
+		placement == TargetPlacementReplicaAntiAffinity
+}
+
+// getTargetNodeAffinity returns the node affinity of the target pod for the
+// placement mode requested on CVC. Preferred rules are used so that the
+// target pod can still be scheduled when the preference can't be satisfied.
+func (c *CVCController) getTargetNodeAffinity(
+	cvc *apis.CStorVolumeConfig,
+	policySpec *apis.CStorVolumePolicySpec,
+) (*corev1.NodeAffinity, error) {
+	var term corev1.NodeSelectorTerm
+
+	switch getTargetPlacement(cvc) {
+	case TargetPlacementApp:
+		if cvc.Publish.NodeID == "" {
+			return nil, nil
+		}
+		term.MatchFields = []corev1.NodeSelectorRequirement{
+			{
+				Key:      nodeNameFieldKey,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{cvc.Publish.NodeID},
+			},
+		}
+	case TargetPlacementReplicaAntiAffinity:
+		hostNames, err := c.getReplicaHostNames(policySpec.ReplicaPoolInfo)
+		if err != nil {
+			return nil, err
+		}
+		if len(hostNames) == 0 {
+			return nil, nil
+		}
+		term.MatchExpressions = []corev1.NodeSelectorRequirement{
+			{
+				Key:      hostNameLabelKey,
+				Operator: corev1.NodeSelectorOpNotIn,
+				Values:   hostNames,
+			},
+		}
+	default:
+		return nil, nil
+	}
+
+	return &corev1.NodeAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+			{
+				Weight:     targetPlacementWeight,
+				Preference: term,
+			},
+		},
+	}, nil
+}
+
+// getReplicaHostNames returns the sorted hostnames of the nodes hosting the
+// pools of volume replicas
+func (c *CVCController) getReplicaHostNames(poolInfo []apis.ReplicaPoolInfo) ([]string, error) {
+	hostNames := []string{}
+	seen := map[string]bool{}
+	for _, info := range poolInfo {
+		cspi, err := c.clientset.CstorV1().CStorPoolInstances(openebsNamespace).
+			Get(context.TODO(), info.PoolName, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get pool %s of volume replica", info.PoolName)
+		}
+		if cspi.Spec.HostName == "" || seen[cspi.Spec.HostName] {
+			continue
+		}
+		seen[cspi.Spec.HostName] = true
+		hostNames = append(hostNames, cspi.Spec.HostName)
+	}
+	// sorted values keeps the deployment spec stable across the syncs
+	sort.Strings(hostNames)
+	return hostNames, nil
+}
+
+// getDeployNodeAffinity returns the node affinity of the target pod
+func getDeployNodeAffinity(deployObj *appsv1.Deployment) *corev1.NodeAffinity {
+	if deployObj.Spec.Template.Spec.Affinity == nil {
+		return nil
+	}
+	return deployObj.Spec.Template.Spec.Affinity.NodeAffinity
+}
+
+// applyTargetPlacement keeps the node affinity of the running target on the
+// updated target deployment unless the other changes of the pod template
+// restart the target pod anyway. Placement is only a scheduling preference
+// which doesn't move a running pod, hence it is never worth restarting the
+// target i.e. failing the IOs of the volume. The placement computed on the
+// policy sync applies at the next restart of the target pod.
+func (c *CVCController) applyTargetPlacement(original, updated *appsv1.Deployment) error {
+	placement := getDeployNodeAffinity(updated)
+	current := getDeployNodeAffinity(original)
+	if reflect.DeepEqual(placement, current) {
+		return nil
+	}
+
+	if updated.Spec.Template.Spec.Affinity == nil {
+		updated.Spec.Template.Spec.Affinity = &corev1.Affinity{}
+	}
+	updated.Spec.Template.Spec.Affinity.NodeAffinity = current
+	restart, err := c.isTargetPodRestarted(original, updated)
+	if err != nil || !restart {
+		return err
+	}
+	klog.Infof("Updating placement of target %s along with the policy changes", original.Name)
+	updated.Spec.Template.Spec.Affinity.NodeAffinity = placement
+	return nil
+}
+
+// isTargetPodRestarted returns true if updating the target deployment changes
+// its pod template. It is found via a server side dry run so that the
+// defaults filled by the server aren't mistaken for changes.
+func (c *CVCController) isTargetPodRestarted(original, updated *appsv1.Deployment) (bool, error) {
+	patchBytes, err := getTargetDeploymentPatch(original, updated)
+	if err != nil {
+		return false, err
+	}
+	deployObj, err := c.kubeclientset.AppsV1().Deployments(original.Namespace).
+		Patch(context.TODO(), original.Name, types.StrategicMergePatchType, patchBytes,
+			metav1.PatchOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to dry run patch of target deployment %s", original.Name)
+	}
+	return !apiequality.Semantic.DeepEqual(original.Spec.Template, deployObj.Spec.Template), nil
+}
@@ -18,81 +18,39 @@ package cstorvolumeconfig
 
 import (
 	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
+	"github.com/openebs/cstor-operators/pkg/util/defaults"
 )
 
-var (
-	// defaultQueueDepth represents the queue size at iSCSI target which limits
-	// the ongoing IO count from client.
-	defaultQueueDepth = "32"
-	// defaultIOWorkers represents default (luWorker) number of threads that are
-	// working on queue
-	defaultIOWorkers = int64(6)
-)
-
-type policyOptFuncs func(*apis.CStorVolumePolicySpec, apis.CStorVolumePolicySpec)
+// targetAnnotationKeys are the annotations on volume policy which configure
+// the target of the volume. These are copied on CVC during provisioning so
+// that later changes to the policy doesn't affect the provisioned volumes.
+var targetAnnotationKeys = []string{
+	TargetStandbyKey,
+	TargetPlacementKey,
+}
 
 // validatePolicySpec validates the provided policy created by the user and
 // otherwise sets the defaults policy spec for cstor volumes.
 func validatePolicySpec(policy *apis.CStorVolumePolicySpec) {
-	defaultPolicy := getDefaultPolicySpec()
-	optFuncs := []policyOptFuncs{
-		defaultTargetPolicy, defaultReplicaPolicy,
-	}
-	for _, o := range optFuncs {
-		o(policy, defaultPolicy)
-	}
-}
-
-// defaultTargetPolicy configure the default volume target deployment related policies
-func defaultTargetPolicy(policy *apis.CStorVolumePolicySpec, defaultPolicy apis.CStorVolumePolicySpec) {
-	if policy.Target.Resources == nil {
-		policy.Target.Resources = defaultPolicy.Target.Resources
-	}
-	if policy.Target.AuxResources == nil {
-		policy.Target.AuxResources = defaultPolicy.Target.AuxResources
-	}
-	if policy.Target.IOWorkers == 0 {
-		policy.Target.IOWorkers = defaultPolicy.Target.IOWorkers
-	}
-	if policy.Target.QueueDepth == "" {
-		policy.Target.QueueDepth = defaultPolicy.Target.QueueDepth
-	}
-
-}
-
-// defaultReplicaPolicy configure the default volume replica related policies
-func defaultReplicaPolicy(policy *apis.CStorVolumePolicySpec, defaultPolicy apis.CStorVolumePolicySpec) {
+	defaults.SetVolumePolicyDefaults(policy)
 }
 
 // getDefaultPolicySpec generate default cstor volume policy spec.
 func getDefaultPolicySpec() apis.CStorVolumePolicySpec {
-	return apis.CStorVolumePolicySpec{
-		Target: apis.TargetSpec{
-			Resources: &corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("0"),
-					corev1.ResourceMemory: resource.MustParse("0"),
-				},
-				Limits: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("0"),
-					corev1.ResourceMemory: resource.MustParse("0"),
-				},
-			},
-			AuxResources: &corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("0"),
-					corev1.ResourceMemory: resource.MustParse("0"),
-				},
-				Limits: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("0"),
-					corev1.ResourceMemory: resource.MustParse("0"),
-				},
-			},
-			QueueDepth: defaultQueueDepth,
-			IOWorkers:  defaultIOWorkers,
-		},
-		Replica: apis.ReplicaSpec{},
+	return defaults.VolumePolicySpec()
+}
+
+// setTargetAnnotations copies the target annotations of volume policy on CVC
+// unless CVC already has them
+func setTargetAnnotations(policy *apis.CStorVolumePolicy, cvc *apis.CStorVolumeConfig) {
+	for _, key := range targetAnnotationKeys {
+		value := policy.GetAnnotations()[key]
+		if value == "" || cvc.GetAnnotations()[key] != "" {
+			continue
+		}
+		if cvc.Annotations == nil {
+			cvc.Annotations = map[string]string{}
+		}
+		cvc.Annotations[key] = value
 	}
 }
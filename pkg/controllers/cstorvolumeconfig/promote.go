@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/cstor-operators/pkg/volumereplica"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// promoteAnnotation is the annotation on CVC to request promotion of a
+	// clone volume to an independent volume
+	promoteAnnotation = "cstorvolumeconfig.openebs.io/promote"
+
+	// CStorVolumeConfigPromoting is the condition on CVC while replicas of
+	// the clone volume are being promoted
+	CStorVolumeConfigPromoting apis.CStorVolumeConfigConditionType = "Promoting"
+	// CStorVolumeConfigPromoteFailed is the condition on CVC when clone
+	// volume can't be promoted
+	CStorVolumeConfigPromoteFailed apis.CStorVolumeConfigConditionType = "VolumePromoteFailed"
+	// CStorVolumeConfigPromoteSuccess is the event reason when clone volume
+	// is promoted successfully
+	CStorVolumeConfigPromoteSuccess = "VolumePromoteSuccessful"
+)
+
+var knownPromoteConditions = map[apis.CStorVolumeConfigConditionType]bool{
+	CStorVolumeConfigPromoting:     true,
+	CStorVolumeConfigPromoteFailed: true,
+}
+
+// isPromotePending returns true if promotion of the bound clone volume is
+// requested via CVC annotation
+func (c *CVCController) isPromotePending(cvc *apis.CStorVolumeConfig) bool {
+	return cvc.GetAnnotations()[promoteAnnotation] == "true" &&
+		cvc.Status.Phase == apis.CStorVolumeConfigPhaseBound &&
+		cvc.Spec.CStorVolumeSource != ""
+}
+
+// promoteVolume promotes the clone volume so that it no longer depends on the
+// snapshot of source volume. It performs following steps:
+//  1. Verify that the clone can be promoted. Clone of a clone or a source
+//     volume having other clones can't be promoted as ZFS moves the origin
+//     snapshot and older snapshots of the source to the promoted clone.
+//  2. Request promotion on every replica of the volume and mark CVC with
+//     Promoting condition.
+//  3. Once all the replicas are promoted then the dependency between volumes
+//     is reversed, i.e source volume now depends on the promoted volume. Hence
+//     source-volume label is moved from the clone to the source volume and
+//     the volume source is removed from CVC.
+func (c *CVCController) promoteVolume(cvc *apis.CStorVolumeConfig) error {
+	srcVolName, _, err := getSrcDetails(cvc.Spec.CStorVolumeSource)
+	if err != nil {
+		return err
+	}
+
+	cvrList, err := c.clientset.CstorV1().CStorVolumeReplicas(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{LabelSelector: pvSelector + "=" + cvc.Name})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list replicas of volume %s", cvc.Name)
+	}
+	// the volumes may no longer pass the validation once the replicas are
+	// promoted e.g. if marking the promote as finished failed midway
+	if !isPromoteCompleted(cvrList.Items) {
+		err = c.validatePromote(cvc, srcVolName)
+		if err != nil {
+			c.recorder.Event(cvc, corev1.EventTypeWarning, string(CStorVolumeConfigPromoteFailed), err.Error())
+			_, patchErr := c.setPromoteCondition(cvc, CStorVolumeConfigPromoteFailed, err.Error())
+			return patchErr
+		}
+	}
+
+	if !hasCondition(cvc, CStorVolumeConfigPromoting) {
+		cvc, err = c.setPromoteCondition(cvc, CStorVolumeConfigPromoting,
+			fmt.Sprintf("promoting replicas of volume %s", cvc.Name))
+		if err != nil {
+			return err
+		}
+		c.recorder.Event(cvc, corev1.EventTypeNormal, string(CStorVolumeConfigPromoting),
+			fmt.Sprintf("CVCController is promoting volume %s", cvc.Name))
+	}
+
+	pendingCount := 0
+	for _, cvr := range cvrList.Items {
+		cvr := cvr
+		switch cvr.GetAnnotations()[volumereplica.PromoteKey] {
+		case volumereplica.PromoteCompleted:
+			continue
+		case volumereplica.PromoteRequested:
+		default:
+			patchBytes, err := getMetadataPatch("annotations",
+				map[string]interface{}{volumereplica.PromoteKey: volumereplica.PromoteRequested})
+			if err != nil {
+				return err
+			}
+			_, err = c.clientset.CstorV1().CStorVolumeReplicas(openebsNamespace).
+				Patch(context.TODO(), cvr.Name, ktypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+			if err != nil {
+				return errors.Wrapf(err, "failed to request promote of replica %s", cvr.Name)
+			}
+		}
+		pendingCount++
+	}
+	if pendingCount != 0 {
+		klog.Infof("Waiting for %d replica(s) of volume %s to get promoted", pendingCount, cvc.Name)
+		return nil
+	}
+	return c.markCVCPromoteFinished(cvc, srcVolName)
+}
+
+// isPromoteCompleted returns true if every replica of the volume is promoted
+func isPromoteCompleted(cvrs []apis.CStorVolumeReplica) bool {
+	if len(cvrs) == 0 {
+		return false
+	}
+	for _, cvr := range cvrs {
+		if cvr.GetAnnotations()[volumereplica.PromoteKey] != volumereplica.PromoteCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+// validatePromote returns error if the clone volume can't be promoted
+func (c *CVCController) validatePromote(cvc *apis.CStorVolumeConfig, srcVolName string) error {
+	srcCV, err := c.clientset.CstorV1().CStorVolumes(openebsNamespace).
+		Get(context.TODO(), srcVolName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source volume %s", srcVolName)
+	}
+	switch srcCV.GetLabels()[string(apis.SourceVolumeKey)] {
+	case "":
+	case cvc.Name:
+		// source volume already depends on the volume being promoted
+		return nil
+	default:
+		return errors.Errorf("source volume %s is a clone of volume %s",
+			srcVolName, srcCV.GetLabels()[string(apis.SourceVolumeKey)])
+	}
+
+	cloneList, err := c.clientset.CstorV1().CStorVolumes(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: string(apis.SourceVolumeKey) + "=" + srcVolName,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list clones of volume %s", srcVolName)
+	}
+	for _, cv := range cloneList.Items {
+		if cv.Name != cvc.Name {
+			return errors.Errorf("source volume %s has other clone %s", srcVolName, cv.Name)
+		}
+	}
+	return nil
+}
+
+// markCVCPromoteFinished moves the source-volume label from the promoted
+// volume to the source volume and clears the volume source of CVC. The label
+// is removed from the promoted volume first so that both the volumes never
+// depend on each other, and the volumes already updated by an earlier
+// attempt are left as is. The volumes are patched so that the concurrent
+// status updates by their targets don't conflict with the promote.
+func (c *CVCController) markCVCPromoteFinished(cvc *apis.CStorVolumeConfig, srcVolName string) error {
+	cv, err := c.clientset.CstorV1().CStorVolumes(openebsNamespace).
+		Get(context.TODO(), cvc.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get volume %s", cvc.Name)
+	}
+	if _, ok := cv.Labels[string(apis.SourceVolumeKey)]; ok {
+		err = c.patchCVLabel(cv.Name, string(apis.SourceVolumeKey), nil)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update volume %s", cvc.Name)
+		}
+	}
+
+	srcCV, err := c.clientset.CstorV1().CStorVolumes(openebsNamespace).
+		Get(context.TODO(), srcVolName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source volume %s", srcVolName)
+	}
+	if srcCV.Labels[string(apis.SourceVolumeKey)] != cvc.Name {
+		err = c.patchCVLabel(srcCV.Name, string(apis.SourceVolumeKey), cvc.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update source volume %s", srcVolName)
+		}
+	}
+
+	newCVC := cvc.DeepCopy()
+	newCVC.Spec.CStorVolumeSource = ""
+	delete(newCVC.Labels, string(apis.SourceVolumeKey))
+	delete(newCVC.Annotations, promoteAnnotation)
+	newCVC.Status.Conditions = mergePromoteConditionsOfCVC(newCVC.Status.Conditions, nil)
+	_, err = c.PatchCVCStatus(cvc, newCVC)
+	if err != nil {
+		return errors.Wrapf(err, "failed to mark cvc %s as promoted", cvc.Name)
+	}
+
+	klog.Infof("Promote of volume %s finished", cvc.Name)
+	c.recorder.Eventf(cvc, corev1.EventTypeNormal, CStorVolumeConfigPromoteSuccess,
+		"Promote volume succeeded, source volume %s now depends on volume %s", srcVolName, cvc.Name)
+	return nil
+}
+
+// patchCVLabel sets the label of the given volume, nil value removes the
+// label
+func (c *CVCController) patchCVLabel(cvName, key string, value interface{}) error {
+	patchBytes, err := getMetadataPatch("labels", map[string]interface{}{key: value})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CstorV1().CStorVolumes(openebsNamespace).
+		Patch(context.TODO(), cvName, ktypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// getMetadataPatch returns the merge patch of the given metadata field i.e.
+// labels or annotations, nil values remove the keys
+func getMetadataPatch(field string, values map[string]interface{}) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			field: values,
+		},
+	})
+}
+
+// setPromoteCondition replaces the promote conditions of CVC with the given
+// condition
+func (c *CVCController) setPromoteCondition(cvc *apis.CStorVolumeConfig,
+	condType apis.CStorVolumeConfigConditionType, message string) (*apis.CStorVolumeConfig, error) {
+	if cond := getCondition(cvc, condType); cond != nil && cond.Message == message {
+		return cvc, nil
+	}
+	condition := apis.CStorVolumeConfigCondition{
+		Type:               condType,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(condType),
+		Message:            message,
+	}
+	newCVC := cvc.DeepCopy()
+	newCVC.Status.Conditions = mergePromoteConditionsOfCVC(newCVC.Status.Conditions,
+		[]apis.CStorVolumeConfigCondition{condition})
+	return c.PatchCVCStatus(cvc, newCVC)
+}
+
+// mergePromoteConditionsOfCVC replaces the promote conditions with the given
+// conditions leaving other conditions untouched
+func mergePromoteConditionsOfCVC(oldConditions,
+	promoteConditions []apis.CStorVolumeConfigCondition) []apis.CStorVolumeConfigCondition {
+	newConditions := []apis.CStorVolumeConfigCondition{}
+	for _, condition := range oldConditions {
+		if !knownPromoteConditions[condition.Type] {
+			newConditions = append(newConditions, condition)
+		}
+	}
+	return append(newConditions, promoteConditions...)
+}
+
+// getCondition returns the condition of given type from CVC
+func getCondition(cvc *apis.CStorVolumeConfig,
+	condType apis.CStorVolumeConfigConditionType) *apis.CStorVolumeConfigCondition {
+	for i := range cvc.Status.Conditions {
+		if cvc.Status.Conditions[i].Type == condType {
+			return &cvc.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// hasCondition returns true if CVC has condition of given type
+func hasCondition(cvc *apis.CStorVolumeConfig, condType apis.CStorVolumeConfigConditionType) bool {
+	return getCondition(cvc, condType) != nil
+}
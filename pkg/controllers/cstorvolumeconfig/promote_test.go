@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"testing"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/cstor-operators/pkg/volumereplica"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPromoteCV(name, sourceVolume string) *apis.CStorVolume {
+	cv := &apis.CStorVolume{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if sourceVolume != "" {
+		cv.Labels = map[string]string{string(apis.SourceVolumeKey): sourceVolume}
+	}
+	return cv
+}
+
+func newPromoteCVR(name, volumeName, promote string) *apis.CStorVolumeReplica {
+	return &apis.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      map[string]string{pvSelector: volumeName},
+			Annotations: map[string]string{volumereplica.PromoteKey: promote},
+		},
+	}
+}
+
+func TestPromoteVolume(t *testing.T) {
+	tests := map[string]struct {
+		srcLabel        string
+		cloneLabel      string
+		promote         string
+		expectPromoted  bool
+		expectCondition apis.CStorVolumeConfigConditionType
+	}{
+		"replicas are requested to promote": {
+			cloneLabel:      "pvc-src",
+			expectCondition: CStorVolumeConfigPromoting,
+		},
+		"replicas being promoted": {
+			cloneLabel:      "pvc-src",
+			promote:         volumereplica.PromoteRequested,
+			expectCondition: CStorVolumeConfigPromoting,
+		},
+		"replicas promoted": {
+			cloneLabel:     "pvc-src",
+			promote:        volumereplica.PromoteCompleted,
+			expectPromoted: true,
+		},
+		"source labelled by earlier attempt": {
+			srcLabel:       "pvc-clone",
+			cloneLabel:     "pvc-src",
+			promote:        volumereplica.PromoteCompleted,
+			expectPromoted: true,
+		},
+		"clone label removed by earlier attempt": {
+			promote:        volumereplica.PromoteCompleted,
+			expectPromoted: true,
+		},
+		"source is a clone": {
+			srcLabel:        "pvc-other",
+			cloneLabel:      "pvc-src",
+			expectCondition: CStorVolumeConfigPromoteFailed,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			openebsNamespace = namespace
+			f := newFixture(t)
+			f.openebsObjects = append(f.openebsObjects,
+				&apis.CStorVolumeConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "pvc-clone",
+						Namespace:   namespace,
+						Annotations: map[string]string{promoteAnnotation: "true"},
+					},
+					Spec:   apis.CStorVolumeConfigSpec{CStorVolumeSource: "pvc-src@snap"},
+					Status: apis.CStorVolumeConfigStatus{Phase: apis.CStorVolumeConfigPhaseBound},
+				},
+				newPromoteCV("pvc-src", test.srcLabel),
+				newPromoteCV("pvc-clone", test.cloneLabel),
+				newPromoteCVR("pvc-clone-cspi-1", "pvc-clone", test.promote),
+				newPromoteCVR("pvc-clone-cspi-2", "pvc-clone", test.promote),
+			)
+			f.SetFakeClient()
+			c, _, _, _ := f.newCVCController()
+			cvc, err := c.clientset.CstorV1().CStorVolumeConfigs(namespace).
+				Get(context.TODO(), "pvc-clone", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.promoteVolume(cvc); err != nil {
+				t.Fatalf("%s test case failed: unexpected error %v", name, err)
+			}
+
+			cvc, _ = c.clientset.CstorV1().CStorVolumeConfigs(namespace).
+				Get(context.TODO(), "pvc-clone", metav1.GetOptions{})
+			srcCV, _ := c.clientset.CstorV1().CStorVolumes(namespace).
+				Get(context.TODO(), "pvc-src", metav1.GetOptions{})
+			cloneCV, _ := c.clientset.CstorV1().CStorVolumes(namespace).
+				Get(context.TODO(), "pvc-clone", metav1.GetOptions{})
+			if !test.expectPromoted {
+				if !hasCondition(cvc, test.expectCondition) || cvc.Spec.CStorVolumeSource == "" {
+					t.Errorf("%s test case failed expected condition %s but got %+v",
+						name, test.expectCondition, cvc.Status.Conditions)
+				}
+				return
+			}
+			if cvc.Spec.CStorVolumeSource != "" || len(cvc.Status.Conditions) != 0 {
+				t.Errorf("%s test case failed expected cvc promoted but got %+v", name, cvc)
+			}
+			if srcCV.Labels[string(apis.SourceVolumeKey)] != "pvc-clone" ||
+				cloneCV.Labels[string(apis.SourceVolumeKey)] != "" {
+				t.Errorf("%s test case failed expected source label moved but got source %v clone %v",
+					name, srcCV.Labels, cloneCV.Labels)
+			}
+		})
+	}
+}
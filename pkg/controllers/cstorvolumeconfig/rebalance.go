@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/api/v3/pkg/util"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// RebalancingReplica is the event reason when replica of the volume is
+	// migrated by the rebalancer
+	RebalancingReplica = "RebalancingReplica"
+)
+
+// runRebalancer rebalances the volume replicas across the pools of every
+// CSPC once per interval until stopCh is closed
+func (c *CVCController) runRebalancer(interval time.Duration, maxMigrations int, stopCh <-chan struct{}) {
+	klog.Infof("Starting replica rebalancer, interval: %s max migrations per cspc: %d",
+		interval, maxMigrations)
+	wait.Until(func() { c.rebalanceReplicas(maxMigrations) }, interval, stopCh)
+	klog.Info("Shutting down replica rebalancer")
+}
+
+// rebalanceReplicas rebalances the volume replicas of every CSPC
+func (c *CVCController) rebalanceReplicas(maxMigrations int) {
+	cspcList, err := c.clientset.CstorV1().CStorPoolClusters(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("failed to list cstorpoolclusters to rebalance replicas: %v", err)
+		return
+	}
+	for _, cspc := range cspcList.Items {
+		if err := c.rebalanceCSPC(cspc.Name, maxMigrations); err != nil {
+			klog.Errorf("failed to rebalance replicas of cstorpoolcluster %s: %v", cspc.Name, err)
+		}
+	}
+}
+
+// rebalanceCSPC requests migration of a volume replica from the most loaded
+// pool of the CSPC to the least loaded one as planned by planRebalance.
+// Migrations are throttled, no migration is requested while maxMigrations
+// migrations or scaling of volume replicas are in progress on the CSPC or
+// while a pool of the CSPC is being drained.
+func (c *CVCController) rebalanceCSPC(cspcName string, maxMigrations int) error {
+	poolList, err := c.listCStorPools(cspcName)
+	if err != nil {
+		return err
+	}
+	for _, pool := range poolList.Items {
+		if cspiutil.IsDrainRequested(&pool) {
+			klog.V(4).Infof("Skipping rebalance of cspc %s, pool %s is being drained", cspcName, pool.Name)
+			return nil
+		}
+	}
+	cvcList, err := c.clientset.CstorV1().CStorVolumeConfigs(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: types.CStorPoolClusterLabelKey + "=" + cspcName,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cvc of cspc %s", cspcName)
+	}
+	inProgress := 0
+	for i := range cvcList.Items {
+		if c.isMigratePending(&cvcList.Items[i]) || c.isCVCScalePending(&cvcList.Items[i]) {
+			inProgress++
+		}
+	}
+	if inProgress >= maxMigrations {
+		klog.V(4).Infof("Skipping rebalance of cspc %s, %d migration(s) in progress", cspcName, inProgress)
+		return nil
+	}
+
+	cvc, fromPool, toPool := planRebalance(cvcList.Items, poolList, c.isRebalanceAllowed)
+	if cvc == nil {
+		return nil
+	}
+	if _, err := c.requestMigration(cvc, fromPool, toPool); err != nil {
+		return err
+	}
+	klog.Infof("Rebalancing replica of volume %s from pool %s to pool %s", cvc.Name, fromPool, toPool)
+	c.recorder.Eventf(cvc, corev1.EventTypeNormal, RebalancingReplica,
+		"Rebalancing replica from pool %s to pool %s", fromPool, toPool)
+	return nil
+}
+
+// isRebalanceAllowed returns true if replica of the volume can be migrated by
+// the rebalancer i.e. the volume is Healthy and the PDB of the volume allows
+// disruption of a pool. PDB allows no disruption when pool pod of a replica
+// is already unavailable or a volume can't lose another replica, removing a
+// replica of the volume is avoided then.
+func (c *CVCController) isRebalanceAllowed(cvc *apis.CStorVolumeConfig) bool {
+	if cvc.Status.Phase != apis.CStorVolumeConfigPhaseBound ||
+		c.isMigratePending(cvc) || c.isCVCScalePending(cvc) {
+		return false
+	}
+	cv, err := c.clientset.CstorV1().CStorVolumes(openebsNamespace).
+		Get(context.TODO(), cvc.Name, metav1.GetOptions{})
+	if err != nil {
+		klog.Errorf("failed to get cstorvolume %s: %v", cvc.Name, err)
+		return false
+	}
+	if cv.Status.Phase != apis.CVStatusHealthy {
+		return false
+	}
+	pdbName := getPoolPDBName(getCSPC(cvc))
+	pdb, err := c.kubeclientset.PolicyV1().PodDisruptionBudgets(openebsNamespace).
+		Get(context.TODO(), pdbName, metav1.GetOptions{})
+	if err != nil {
+		if k8serror.IsNotFound(err) {
+			return true
+		}
+		klog.Errorf("failed to get pdb %s of volume %s: %v", pdbName, cvc.Name, err)
+		return false
+	}
+	return pdb.Status.DisruptionsAllowed > 0
+}
+
+// planRebalance returns the volume whose replica is to be migrated along with
+// the pools to migrate the replica from and to. Online pools which are neither
+// read only nor cordoned are ordered by the count of volume replicas on them
+// and then by the share of their capacity in use. A replica is migrated from a
+// pool only to a pool having at least 2 replicas less and not using a larger
+// share of its capacity. Among the volumes of a pool which are allowed to be
+// migrated, the smallest volume is migrated first as it is rebuilt the
+// fastest. Nil volume is returned if the pools are balanced.
+func planRebalance(cvcs []apis.CStorVolumeConfig, poolList *apis.CStorPoolInstanceList,
+	isAllowed func(*apis.CStorVolumeConfig) bool) (*apis.CStorVolumeConfig, string, string) {
+	pools := []apis.CStorPoolInstance{}
+	for _, pool := range poolList.Items {
+		if string(pool.Status.Phase) == cspiOnline && !pool.Status.ReadOnly && !cspiutil.IsCordoned(&pool) {
+			pools = append(pools, pool)
+		}
+	}
+	replicaCount := map[string]int{}
+	for _, cvc := range cvcs {
+		for _, poolName := range cvc.Status.PoolInfo {
+			replicaCount[poolName]++
+		}
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		if replicaCount[pools[i].Name] != replicaCount[pools[j].Name] {
+			return replicaCount[pools[i].Name] < replicaCount[pools[j].Name]
+		}
+		if usedShare(pools[i]) != usedShare(pools[j]) {
+			return usedShare(pools[i]) < usedShare(pools[j])
+		}
+		return pools[i].Name < pools[j].Name
+	})
+
+	cvcs = append([]apis.CStorVolumeConfig{}, cvcs...)
+	sort.Slice(cvcs, func(i, j int) bool {
+		capacityI := cvcs[i].Spec.Capacity[corev1.ResourceStorage]
+		if cmp := capacityI.Cmp(cvcs[j].Spec.Capacity[corev1.ResourceStorage]); cmp != 0 {
+			return cmp < 0
+		}
+		return cvcs[i].Name < cvcs[j].Name
+	})
+	allowed := map[string]bool{}
+	isAllowedOnce := func(cvc *apis.CStorVolumeConfig) bool {
+		if v, ok := allowed[cvc.Name]; ok {
+			return v
+		}
+		allowed[cvc.Name] = isAllowed(cvc)
+		return allowed[cvc.Name]
+	}
+
+	for from := len(pools) - 1; from > 0; from-- {
+		fromPool := pools[from]
+		for to := 0; to < from; to++ {
+			toPool := pools[to]
+			if replicaCount[fromPool.Name]-replicaCount[toPool.Name] < 2 {
+				break
+			}
+			if usedShare(toPool) > usedShare(fromPool) {
+				continue
+			}
+			for i := range cvcs {
+				cvc := &cvcs[i]
+				if !util.ContainsString(cvc.Status.PoolInfo, fromPool.Name) ||
+					!containsPool(replicaTargetPools(cvc, fromPool.Name, poolList), toPool.Name) ||
+					!isAllowedOnce(cvc) {
+					continue
+				}
+				return cvc, fromPool.Name, toPool.Name
+			}
+		}
+	}
+	return nil, "", ""
+}
+
+// usedShare returns the share of the pool capacity in use
+func usedShare(pool apis.CStorPoolInstance) float64 {
+	total := pool.Status.Capacity.Total.Value()
+	if total == 0 {
+		return 0
+	}
+	return float64(pool.Status.Capacity.Used.Value()) / float64(total)
+}
+
+// containsPool returns true if the pool is present in pools
+func containsPool(pools []apis.CStorPoolInstance, poolName string) bool {
+	for _, pool := range pools {
+		if pool.Name == poolName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"context"
+	"testing"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	corev1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newRebalanceCSPI(name, used string) *apis.CStorPoolInstance {
+	cspi := newDrainCSPI(name, "node-"+name, "90Gi", false)
+	cspi.Status.Capacity.Used = resource.MustParse(used)
+	cspi.Status.Capacity.Total = resource.MustParse("100Gi")
+	return cspi
+}
+
+func newRebalanceCVC(name, capacity string, pools ...string) *apis.CStorVolumeConfig {
+	cvc := newMigrateCVC("", pools...)
+	cvc.Name = name
+	delete(cvc.Annotations, cvcutil.MigrateReplicaAnnotation)
+	cvc.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)}
+	return cvc
+}
+
+func TestPlanRebalance(t *testing.T) {
+	cordoned := newRebalanceCSPI("cspi-4", "0Gi")
+	cordoned.Annotations = map[string]string{cspiutil.CordonAnnotation: "true"}
+	tests := map[string]struct {
+		pools        []*apis.CStorPoolInstance
+		cvcs         []*apis.CStorVolumeConfig
+		notAllowed   string
+		expectedPlan string
+	}{
+		"smallest volume of the most used pool is migrated to the new pool": {
+			pools: []*apis.CStorPoolInstance{
+				newRebalanceCSPI("cspi-1", "20Gi"), newRebalanceCSPI("cspi-2", "10Gi"), newRebalanceCSPI("cspi-3", "0Gi"),
+			},
+			cvcs: []*apis.CStorVolumeConfig{
+				newRebalanceCVC("pvc-1", "5Gi", "cspi-1", "cspi-2"),
+				newRebalanceCVC("pvc-2", "2Gi", "cspi-1", "cspi-2"),
+			},
+			expectedPlan: "pvc-2:cspi-1:cspi-3",
+		},
+		"volumes not allowed to migrate are skipped": {
+			pools: []*apis.CStorPoolInstance{
+				newRebalanceCSPI("cspi-1", "20Gi"), newRebalanceCSPI("cspi-2", "10Gi"), newRebalanceCSPI("cspi-3", "0Gi"),
+			},
+			cvcs: []*apis.CStorVolumeConfig{
+				newRebalanceCVC("pvc-1", "5Gi", "cspi-1", "cspi-2"),
+				newRebalanceCVC("pvc-2", "2Gi", "cspi-1", "cspi-2"),
+			},
+			notAllowed:   "pvc-2",
+			expectedPlan: "pvc-1:cspi-1:cspi-3",
+		},
+		"pools are balanced": {
+			pools: []*apis.CStorPoolInstance{
+				newRebalanceCSPI("cspi-1", "20Gi"), newRebalanceCSPI("cspi-2", "10Gi"), newRebalanceCSPI("cspi-3", "0Gi"),
+			},
+			cvcs: []*apis.CStorVolumeConfig{
+				newRebalanceCVC("pvc-1", "5Gi", "cspi-1", "cspi-2"),
+			},
+		},
+		"cordoned pools are not rebalanced": {
+			pools: []*apis.CStorPoolInstance{
+				newRebalanceCSPI("cspi-1", "20Gi"), newRebalanceCSPI("cspi-2", "10Gi"), cordoned,
+			},
+			cvcs: []*apis.CStorVolumeConfig{
+				newRebalanceCVC("pvc-1", "5Gi", "cspi-1", "cspi-2"),
+				newRebalanceCVC("pvc-2", "2Gi", "cspi-1", "cspi-2"),
+			},
+		},
+		"replica is not migrated to pool using larger share of capacity": {
+			pools: []*apis.CStorPoolInstance{
+				newRebalanceCSPI("cspi-1", "20Gi"), newRebalanceCSPI("cspi-2", "10Gi"), newRebalanceCSPI("cspi-3", "50Gi"),
+			},
+			cvcs: []*apis.CStorVolumeConfig{
+				newRebalanceCVC("pvc-1", "5Gi", "cspi-1", "cspi-2"),
+				newRebalanceCVC("pvc-2", "2Gi", "cspi-1", "cspi-2"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			poolList := &apis.CStorPoolInstanceList{}
+			for _, pool := range test.pools {
+				poolList.Items = append(poolList.Items, *pool)
+			}
+			cvcs := []apis.CStorVolumeConfig{}
+			for _, cvc := range test.cvcs {
+				cvcs = append(cvcs, *cvc)
+			}
+			cvc, fromPool, toPool := planRebalance(cvcs, poolList, func(cvc *apis.CStorVolumeConfig) bool {
+				return cvc.Name != test.notAllowed
+			})
+			got := ""
+			if cvc != nil {
+				got = cvc.Name + ":" + fromPool + ":" + toPool
+			}
+			if got != test.expectedPlan {
+				t.Errorf("expected plan %q but got %q", test.expectedPlan, got)
+			}
+		})
+	}
+}
+
+func TestRebalanceCSPC(t *testing.T) {
+	openebsNamespace = namespace
+	f := newFixture(t)
+	cvc1 := newRebalanceCVC("pvc-1", "5Gi", "cspi-1", "cspi-2")
+	cvc2 := newRebalanceCVC("pvc-2", "2Gi", "cspi-1", "cspi-2")
+	f.openebsObjects = append(f.openebsObjects, cvc1, cvc2,
+		newRebalanceCSPI("cspi-1", "20Gi"), newRebalanceCSPI("cspi-2", "10Gi"), newRebalanceCSPI("cspi-3", "0Gi"),
+		&apis.CStorVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: namespace},
+			Status:     apis.CStorVolumeStatus{Phase: apis.CVStatusHealthy},
+		},
+		&apis.CStorVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc-2", Namespace: namespace},
+			Status:     apis.CStorVolumeStatus{Phase: apis.CVStatusDegraded},
+		},
+	)
+	f.k8sObjects = append(f.k8sObjects, &policy.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: getPoolPDBName("cspc"), Namespace: namespace},
+	})
+	f.SetFakeClient()
+	c, _, _, _ := f.newCVCController()
+	cvcs := f.openebsClient.CstorV1().CStorVolumeConfigs(namespace)
+	migration := func(name string) string {
+		cvc, err := cvcs.Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cvc.Annotations[cvcutil.MigrateReplicaAnnotation]
+	}
+
+	// pvc-2 is degraded and PDB of pools allows no disruption
+	if err := c.rebalanceCSPC("cspc", 1); err != nil {
+		t.Fatalf("unexpected error rebalancing replicas: %v", err)
+	}
+	if migration("pvc-1") != "" || migration("pvc-2") != "" {
+		t.Fatalf("expected no migration but got %q, %q", migration("pvc-1"), migration("pvc-2"))
+	}
+
+	pdbs := f.k8sClient.PolicyV1().PodDisruptionBudgets(namespace)
+	pdb, _ := pdbs.Get(context.TODO(), getPoolPDBName("cspc"), metav1.GetOptions{})
+	pdb.Status.DisruptionsAllowed = 1
+	_, _ = pdbs.UpdateStatus(context.TODO(), pdb, metav1.UpdateOptions{})
+	if err := c.rebalanceCSPC("cspc", 1); err != nil {
+		t.Fatalf("unexpected error rebalancing replicas: %v", err)
+	}
+	if got := migration("pvc-1"); got != "cspi-1:cspi-3" {
+		t.Errorf("expected migration cspi-1:cspi-3 of pvc-1 but got %q", got)
+	}
+
+	// no more migration is requested while a migration is in progress
+	cv, _ := f.openebsClient.CstorV1().CStorVolumes(namespace).Get(context.TODO(), "pvc-2", metav1.GetOptions{})
+	cv.Status.Phase = apis.CVStatusHealthy
+	_, _ = f.openebsClient.CstorV1().CStorVolumes(namespace).Update(context.TODO(), cv, metav1.UpdateOptions{})
+	if err := c.rebalanceCSPC("cspc", 1); err != nil {
+		t.Fatalf("unexpected error rebalancing replicas: %v", err)
+	}
+	if got := migration("pvc-2"); got != "" {
+		t.Errorf("expected migrations to be throttled but got %q", got)
+	}
+}
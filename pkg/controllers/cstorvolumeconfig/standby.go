@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A volume with warm standby target runs two target pods. Both pods start
+// istgt with the volume configuration but only the pod holding the target
+// Lease carries the active role label, and the target service selects the
+// pod by that label. When the active pod is lost the standby acquires the
+// Lease, labels itself active and the replicas reconnect to it through the
+// target service.
+const (
+	// TargetStandbyKey is the annotation on CStorVolumePolicy, CVC and CV
+	// to enable warm standby target for the volume
+	TargetStandbyKey = "cstor.openebs.io/target-standby"
+	// TargetRoleKey is the label on target pods holding the role of the pod
+	TargetRoleKey = "openebs.io/target-role"
+	// TargetRoleActive is the role of the target pod that serves IOs
+	TargetRoleActive = "active"
+	// TargetRoleStandby is the role of the target pod waiting for the Lease
+	TargetRoleStandby = "standby"
+
+	// standbyDeployReplicas is the replica count for target deployment
+	// having warm standby target
+	standbyDeployReplicas int32 = 2
+)
+
+// hasTargetStandby returns true if the object is provisioned with warm
+// standby target
+func hasTargetStandby(obj metav1.Object) bool {
+	return obj.GetAnnotations()[TargetStandbyKey] == "true"
+}
+
+// getTargetStandbyAffinity returns the pod anti affinity that spreads the
+// target pods of the volume across nodes. It is a preferred rule so that
+// volumes can still be provisioned on single node clusters.
+func getTargetStandbyAffinity(pvName string) *corev1.PodAntiAffinity {
+	return &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+			{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: getDeployMatchLabels(pvName),
+					},
+					TopologyKey: "kubernetes.io/hostname",
+				},
+			},
+		},
+	}
+}
+
+// getTargetStandbyEnvs returns the env required by volume-mgmt side car to
+// participate in target leader election
+func getTargetStandbyEnvs(pvName string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name:  "OPENEBS_IO_TARGET_STANDBY",
+			Value: "true",
+		},
+		{
+			Name:  "OPENEBS_IO_TARGET_LEASE_NAME",
+			Value: pvName + "-target",
+		},
+	}
+}
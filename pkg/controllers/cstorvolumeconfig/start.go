@@ -26,9 +26,11 @@ import (
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	informers "github.com/openebs/api/v3/pkg/client/informers/externalversions"
 	leader "github.com/openebs/api/v3/pkg/kubernetes/leaderelection"
+	"github.com/openebs/cstor-operators/pkg/eventstream"
 	server "github.com/openebs/cstor-operators/pkg/server"
 	cvcserver "github.com/openebs/cstor-operators/pkg/server/cstorvolumeconfig"
 	"github.com/openebs/cstor-operators/pkg/snapshot"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/pkg/errors"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -50,6 +52,8 @@ var (
 	leaderElection          = flag.Bool("leader-election", false, "Enables leader election.")
 	leaderElectionNamespace = flag.String("leader-election-namespace", "", "The namespace where the leader election resource exists. Defaults to the pod namespace if not set.")
 	bindAddr                = flag.String("bind", "", "IP Address to bind for CVC-Operator Server")
+	rebalanceInterval       = flag.Duration("replica-rebalance-interval", 0, "Interval at which volume replicas are rebalanced across the pools of a CSPC. Rebalancing is disabled if 0.")
+	rebalanceMaxMigrations  = flag.Int("replica-rebalance-max-migrations", 1, "Max number of replica migrations in progress per CSPC up to which the rebalancer and the drain of pools migrate replicas.")
 )
 
 // ServerOptions holds information to start the CVC server
@@ -68,6 +72,12 @@ func Start() error {
 	}
 	flag.Parse()
 
+	shutdownTracing, err := tracing.Init("cstor-cvc-operator")
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize tracing")
+	}
+	defer shutdownTracing(context.TODO())
+
 	// Get in cluster config
 	cfg, err := getClusterConfig(*kubeconfig)
 	if err != nil {
@@ -86,8 +96,22 @@ func Start() error {
 		return errors.Wrap(err, "error building openebs clientset")
 	}
 
+	// eventBroker is fed by informers of its own which run irrespective of
+	// leader election, so that every replica of the operator serves the
+	// event stream
+	eventBroker := eventstream.NewBroker(
+		eventstream.NewEpoch(os.Getenv("OPENEBS_CVC_POD_UID"), time.Now()),
+		eventstream.DefaultHistorySize,
+	)
+	defer eventBroker.Stop()
+	streamInformerFactory := informers.NewSharedInformerFactory(openebsClient, time.Second*30)
+	eventstream.NewWatcher(eventBroker, streamInformerFactory)
+	streamStopCh := make(chan struct{})
+	defer close(streamStopCh)
+	streamInformerFactory.Start(streamStopCh)
+
 	// setupCVCServer instantiate the HTTP server to serve the CVC request
-	srvOptions, err := setupCVCServer(kubeClient, openebsClient)
+	srvOptions, err := setupCVCServer(kubeClient, openebsClient, eventBroker)
 	if err != nil {
 		return errors.Wrapf(err, "failed to setupCVCServer")
 	}
@@ -132,6 +156,11 @@ func Start() error {
 		kubeInformerFactory.Start(stopCh)
 		cvcInformerFactory.Start(stopCh)
 		go controller.Run(2, stopCh)
+		go controller.runPoolPDBSync(stopCh)
+		go controller.runDrainer(*rebalanceMaxMigrations, stopCh)
+		if *rebalanceInterval > 0 {
+			go controller.runRebalancer(*rebalanceInterval, *rebalanceMaxMigrations, stopCh)
+		}
 
 		// ...until SIGINT
 		c := make(chan os.Signal, 1)
@@ -164,7 +193,8 @@ func getClusterConfig(kubeconfig string) (*rest.Config, error) {
 }
 
 // setupCVCServer will load the required server configuration and start the CVC server
-func setupCVCServer(k8sclientset kubernetes.Interface, openebsClientset clientset.Interface) (*ServerOptions, error) {
+func setupCVCServer(k8sclientset kubernetes.Interface, openebsClientset clientset.Interface,
+	eventBroker *eventstream.Broker) (*ServerOptions, error) {
 	options := &ServerOptions{}
 	// Load default server config
 	config := server.DefaultServerConfig()
@@ -185,7 +215,8 @@ func setupCVCServer(k8sclientset kubernetes.Interface, openebsClientset clientse
 	cvcServer := cvcserver.NewCVCServer(config, os.Stdout).
 		WithOpenebsClientSet(openebsClientset).
 		WithKubernetesClientSet(k8sclientset).
-		WithSnapshotter(&snapshot.SnapClient{})
+		WithSnapshotter(&snapshot.SnapClient{}).
+		WithEventBroker(eventBroker)
 
 	// Setup the HTTP server
 	http, err := cvcserver.NewHTTPServer(cvcServer)
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+)
+
+const (
+	// MigrateReplicaAnnotation is the annotation on CVC to request migration
+	// of the volume replica from one pool to another, its value is
+	// <from-pool>:<to-pool>
+	MigrateReplicaAnnotation = "cstorvolumeconfig.openebs.io/migrate-replica"
+
+	// CStorVolumeConfigMigrating is the condition on CVC while the volume
+	// replica is being migrated, its message reports the progress
+	CStorVolumeConfigMigrating apis.CStorVolumeConfigConditionType = "MigratingReplica"
+	// CStorVolumeConfigMigrateFailed is the condition on CVC when the volume
+	// replica can't be migrated
+	CStorVolumeConfigMigrateFailed apis.CStorVolumeConfigConditionType = "VolumeReplicaMigrateFailed"
+)
@@ -18,6 +18,7 @@ package cstorvolumeconfig
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -26,10 +27,11 @@ import (
 	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	"github.com/openebs/api/v3/pkg/apis/types"
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/util/hash"
 	"github.com/openebs/cstor-operators/pkg/version"
 	"github.com/openebs/cstor-operators/pkg/volumereplica"
-	policy "k8s.io/api/policy/v1"
 
 	"github.com/openebs/api/v3/pkg/util"
 
@@ -38,6 +40,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 )
@@ -70,6 +73,9 @@ type replicaInfo struct {
 	replicaID string
 	phase     apis.CStorVolumeReplicaPhase
 	ioWorkers string
+	// seedSource is the name of source volume replica from which clone
+	// replica has to be seeded when source snapshot doesn't exist on the pool
+	seedSource string
 }
 
 var (
@@ -125,11 +131,16 @@ func getTargetServiceLabels(claim *apis.CStorVolumeConfig) map[string]string {
 
 // getTargetServiceSelectors get the selectors for cstor volume service
 func getTargetServiceSelectors(claim *apis.CStorVolumeConfig) map[string]string {
-	return map[string]string{
+	selectors := map[string]string{
 		"app":                          "cstor-volume-manager",
 		"openebs.io/target":            "cstor-target",
 		"openebs.io/persistent-volume": claim.Name,
 	}
+	// only the target pod holding the Lease should serve the volume
+	if hasTargetStandby(claim) {
+		selectors[TargetRoleKey] = TargetRoleActive
+	}
+	return selectors
 }
 
 // getTargetServiceOwnerReference get the ownerReference for cstorvolume service
@@ -180,6 +191,16 @@ func getCVLabels(claim *apis.CStorVolumeConfig) map[string]string {
 	}
 }
 
+// getCVAnnotations get the annotations for cstorvolume
+func getCVAnnotations(claim *apis.CStorVolumeConfig) map[string]string {
+	annotations := map[string]string{}
+	if hasTargetStandby(claim) {
+		annotations[TargetStandbyKey] = "true"
+	}
+	tracing.CopyAnnotations(claim.GetAnnotations(), annotations)
+	return annotations
+}
+
 // getCVOwnerReference get the ownerReference for cstorvolume
 func getCVOwnerReference(cvc *apis.CStorVolumeConfig) []metav1.OwnerReference {
 	return []metav1.OwnerReference{
@@ -305,6 +326,7 @@ func (c *CVCController) getOrCreateCStorVolumeResource(
 		cvObj = apis.NewCStorVolume().
 			WithName(claim.Name).
 			WithLabelsNew(volLabels).
+			WithAnnotationsNew(getCVAnnotations(claim)).
 			WithOwnerReference(getCVOwnerReference(claim)).
 			WithTargetIP(service.Spec.ClusterIP).
 			WithCapacity(qCap.String()).
@@ -353,7 +375,9 @@ func (c *CVCController) distributeCVRs(
 ) error {
 	var (
 		usablePoolList *apis.CStorPoolInstanceList
+		seedPoolList   = &apis.CStorPoolInstanceList{}
 		srcVolName     string
+		snapName       string
 		err            error
 	)
 	rInfo := replicaInfo{
@@ -382,21 +406,30 @@ func (c *CVCController) distributeCVRs(
 	}
 
 	if claim.Spec.CStorVolumeSource != "" {
-		srcVolName, _, err = getSrcDetails(claim.Spec.CStorVolumeSource)
+		srcVolName, snapName, err = getSrcDetails(claim.Spec.CStorVolumeSource)
 		if err != nil {
 			return err
 		}
 		usablePoolList = getUsablePoolListForClone(c.clientset, volume.Name, srcVolName, poolList)
+		// pools that doesn't hold the source volume can only be used by
+		// seeding the clone from a pool holding the source snapshot
+		seedPoolList = excludePoolList(
+			getUsablePoolList(c.clientset, volume.Name, poolList),
+			usablePoolList,
+		)
 	} else {
 		usablePoolList = getUsablePoolList(c.clientset, volume.Name, poolList)
 	}
 
-	// sanitizePoolList to remove pool that has status.Phase as offline
-	// or Status.ReadOnly as true from usablePoolList
+	// sanitizePoolList to remove pool that has status.Phase as offline,
+	// Status.ReadOnly as true, is cordoned or is being drained from
+	// usablePoolList
 	usablePoolList = sanitizePoolList(usablePoolList)
+	seedPoolList = sanitizePoolList(seedPoolList)
 
 	// randomizePoolList to get the pool list in random order
 	usablePoolList = randomizePoolList(usablePoolList)
+	seedPoolList = randomizePoolList(seedPoolList)
 
 	// prioritized pool instances matched to the given
 	// nodeName in case of replica affinity is enabled via cstor volume policy
@@ -405,13 +438,14 @@ func (c *CVCController) distributeCVRs(
 			"replica affinity is enabled, nodeID is "+claim.Publish.NodeID,
 		)
 		usablePoolList = prioritizedPoolList(claim.Publish.NodeID, usablePoolList)
+		seedPoolList = prioritizedPoolList(claim.Publish.NodeID, seedPoolList)
 	}
 
-	if len(usablePoolList.Items) < pendingReplicaCount {
+	if len(usablePoolList.Items)+len(seedPoolList.Items) < pendingReplicaCount {
 		return errors.Errorf(
 			"not enough pools are available of provided CSPC: %q, usable pool count: %d pending replica count: %d",
 			cspcName,
-			len(usablePoolList.Items),
+			len(usablePoolList.Items)+len(seedPoolList.Items),
 			pendingReplicaCount,
 		)
 	}
@@ -427,9 +461,90 @@ func (c *CVCController) distributeCVRs(
 			return nil
 		}
 	}
+
+	pendingReplicaCount -= len(usablePoolList.Items)
+	if pendingReplicaCount <= 0 {
+		return nil
+	}
+
+	rInfo.seedSource, err = c.getOrRequestSeedSource(srcVolName, snapName)
+	if err != nil {
+		return err
+	}
+	for _, pool := range seedPoolList.Items[:pendingReplicaCount] {
+		pool := pool
+		_, err = c.createCVR(service, volume, claim, &pool, rInfo)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// getOrRequestSeedSource returns the name of healthy source volume replica
+// holding the given snapshot and requests it to serve the snapshot stream
+// for clone replicas placed on pools without the source volume.
+func (c *CVCController) getOrRequestSeedSource(srcVolName, snapName string) (string, error) {
+	srcCVRList, err := c.clientset.CstorV1().CStorVolumeReplicas(openebsNamespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: pvSelector + "=" + srcVolName,
+		})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list replicas of source volume %s", srcVolName)
+	}
+
+	for _, cvr := range srcCVRList.Items {
+		cvr := cvr
+		if cvr.Status.Phase != apis.CVRStatusOnline {
+			continue
+		}
+		if _, ok := cvr.Status.Snapshots[snapName]; !ok {
+			continue
+		}
+		// a source replica can serve one snapshot at a time
+		requested := cvr.GetAnnotations()[volumereplica.SeedSnapshotKey]
+		if requested != "" && requested != snapName {
+			continue
+		}
+		if requested == "" || cvr.GetAnnotations()[volumereplica.SeedTokenKey] == "" {
+			if err := c.requestSeedSnapshot(&cvr, snapName); err != nil {
+				return "", errors.Wrapf(err,
+					"failed to request snapshot %s from source replica %s", snapName, cvr.Name)
+			}
+		}
+		return cvr.Name, nil
+	}
+	return "", errors.Errorf(
+		"no healthy replica of source volume %s is available to seed snapshot %s",
+		srcVolName, snapName,
+	)
+}
+
+// requestSeedSnapshot requests the source replica to serve the given
+// snapshot along with a new token for the clone replicas. Merge patch is used
+// so that concurrent status updates of the source replica by its pool-manager
+// don't conflict with the request.
+func (c *CVCController) requestSeedSnapshot(cvr *apis.CStorVolumeReplica, snapName string) error {
+	token, err := volumereplica.NewSeedToken()
+	if err != nil {
+		return err
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				volumereplica.SeedSnapshotKey: snapName,
+				volumereplica.SeedTokenKey:    token,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CstorV1().CStorVolumeReplicas(cvr.Namespace).
+		Patch(context.TODO(), cvr.Name, ktypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
 func getSrcDetails(cstorVolumeSrc string) (string, string, error) {
 	volSrc := strings.Split(cstorVolumeSrc, "@")
 	if len(volSrc) == 0 {
@@ -466,12 +581,16 @@ func (c *CVCController) createCVR(
 		annotations[string(apis.SourceVolumeKey)] = srcVolume
 		annotations[string(apis.SnapshotNameKey)] = snapName
 		labels[string(apis.CloneEnableKEY)] = isClone
+		if rInfo.seedSource != "" {
+			annotations[volumereplica.SeedSourceKey] = rInfo.seedSource
+		}
 	}
 	// Set isRestoreVol annotation on CVR if CVC has
 	// "openebs.io/created-through: restore" annotation
 	if value := claim.GetAnnotations()[pvCreatedByKey]; value == createdThroughRestore {
 		annotations[volumereplica.IsRestoreVol] = "true"
 	}
+	tracing.CopyAnnotations(claim.GetAnnotations(), annotations)
 	cvrObj, err := c.clientset.CstorV1().CStorVolumeReplicas(openebsNamespace).
 		Get(context.TODO(), volume.Name+"-"+string(pool.Name), metav1.GetOptions{})
 
@@ -588,6 +707,27 @@ func getUsablePoolListForClone(clientset clientset.Interface, pvName, srcPVName
 	return usablePoolList
 }
 
+// excludePoolList returns the pools of list which are not present in
+// excludeList
+func excludePoolList(list, excludeList *apis.CStorPoolInstanceList) *apis.CStorPoolInstanceList {
+	res := &apis.CStorPoolInstanceList{}
+	if list == nil {
+		return res
+	}
+	excludeMap := map[string]bool{}
+	if excludeList != nil {
+		for _, pool := range excludeList.Items {
+			excludeMap[pool.Name] = true
+		}
+	}
+	for _, pool := range list.Items {
+		if !excludeMap[pool.Name] {
+			res.Items = append(res.Items, pool)
+		}
+	}
+	return res
+}
+
 // prioritizedPoolList prioritized pool instance name matched to the given
 // nodeName in case of replica affinity is enabled via volume policy
 func prioritizedPoolList(nodeName string, list *apis.CStorPoolInstanceList) *apis.CStorPoolInstanceList {
@@ -604,11 +744,14 @@ func prioritizedPoolList(nodeName string, list *apis.CStorPoolInstanceList) *api
 // sanitizePoolList returns santized pool list
 // 1. It removes the pool from the list if its phase is offline.
 // 2. It removes the pool from the list if its readonly.
+// 3. It removes the pool from the list if it is being drained.
+// 4. It removes the pool from the list if it is cordoned.
 func sanitizePoolList(list *apis.CStorPoolInstanceList) *apis.CStorPoolInstanceList {
 	res := &apis.CStorPoolInstanceList{}
 
 	for _, pool := range list.Items {
-		if pool.Status.Phase == apis.CStorPoolStatusOffline || pool.Status.ReadOnly {
+		if pool.Status.Phase == apis.CStorPoolStatusOffline || pool.Status.ReadOnly ||
+			cspiutil.IsDrainRequested(&pool) || cspiutil.IsCordoned(&pool) {
 			continue
 		}
 
@@ -629,30 +772,6 @@ func randomizePoolList(list *apis.CStorPoolInstanceList) *apis.CStorPoolInstance
 	return res
 }
 
-// getOrCreatePodDisruptionBudget will does following things
-// 1. It tries to get the PDB that was created among volume replica pools.
-// 2. If PDB exist it returns the PDB.
-// 3. If PDB doesn't exist it creates new PDB(With CSPC hash)
-func (c *CVCController) getOrCreatePodDisruptionBudget(cspcName string,
-	poolNames []string,
-) (*policy.PodDisruptionBudget, error) {
-
-	pdblabelSelector := GetPDBLabelSelector(poolNames)
-	pdbList, err := c.kubeclientset.PolicyV1().PodDisruptionBudgets(openebsNamespace).
-		List(context.TODO(), metav1.ListOptions{LabelSelector: pdblabelSelector})
-	if err != nil {
-		return nil, errors.Wrapf(err,
-			"failed to list PDB belongs to pools with selector %v", pdblabelSelector)
-	}
-	for _, pdbObj := range pdbList.Items {
-		pdbObj := pdbObj
-		if !util.IsChangeInLists(pdbObj.Spec.Selector.MatchExpressions[0].Values, poolNames) {
-			return &pdbObj, nil
-		}
-	}
-	return c.createPDB(poolNames, cspcName)
-}
-
 // addReplicaPoolInfo updates in-memory replicas pool information on spec and
 // status of CVC
 func addReplicaPoolInfo(cvcObj *apis.CStorVolumeConfig, poolNames []string) {
@@ -677,13 +796,12 @@ func addReplicaPoolInfo(cvcObj *apis.CStorVolumeConfig, poolNames []string) {
 }
 
 // addPDBLabelOnCVC will add PodDisruptionBudget label on CVC
-func addPDBLabelOnCVC(
-	cvcObj *apis.CStorVolumeConfig, pdbObj *policy.PodDisruptionBudget) {
+func addPDBLabelOnCVC(cvcObj *apis.CStorVolumeConfig, pdbName string) {
 	cvcLabels := cvcObj.GetLabels()
 	if cvcLabels == nil {
 		cvcLabels = map[string]string{}
 	}
-	cvcLabels[types.PodDisruptionBudgetKey] = pdbObj.Name
+	cvcLabels[types.PodDisruptionBudgetKey] = pdbName
 	cvcObj.SetLabels(cvcLabels)
 }
 
@@ -698,32 +816,6 @@ func isHAVolume(cvcObj *apis.CStorVolumeConfig) bool {
 	return len(cvcObj.Status.PoolInfo) >= minHAReplicaCount
 }
 
-//  1. If Volume was pointing to PDB then delete PDB if no other CVC is
-//     pointing to PDB.
-//  2. If current volume is HAVolume then check is there any PDB already
-//     existing among the current replica pools. If PDB exists then return
-//     that PDB name. If PDB doesn't exist then create new PDB and return newely
-//     created PDB name.
-//  3. If current volume is not HAVolume then return nothing.
-func (c *CVCController) getUpdatePDBForVolume(cvcObj *apis.CStorVolumeConfig) (string, error) {
-	_, hasPDB := cvcObj.GetLabels()[string(types.PodDisruptionBudgetKey)]
-	if hasPDB {
-		err := c.deletePDBIfNotInUse(cvcObj)
-		if err != nil {
-			return "", err
-		}
-	}
-	if !isHAVolume(cvcObj) {
-		return "", nil
-	}
-
-	pdbObj, err := c.getOrCreatePodDisruptionBudget(getCSPC(cvcObj), cvcObj.Status.PoolInfo)
-	if err != nil {
-		return "", err
-	}
-	return pdbObj.Name, nil
-}
-
 // isCVCScalePending returns true if there is change in desired replica pool
 // names and current replica pool names
 //  1. Below function will check whether there is any change in desired replica
@@ -736,35 +828,18 @@ func (c *CVCController) isCVCScalePending(cvc *apis.CStorVolumeConfig) bool {
 }
 
 // updatePDBForScaledVolume will does the following changes:
-//  1. Handle PDB updation based on no.of volume replicas. It should handle in
-//     following ways:
-//     1.1 If Volume was already pointing to a PDB then check is that same PDB will be
-//     applicable after scalingup/scalingdown(case might be from 4 to 3
-//     replicas) if applicable then return same pdb name. If not applicable do
-//     following changes:
-//     1.1.1 Delete PDB if no other CVC is pointing to PDB.
-//     1.2 If current volume was not pointing to any PDB then do nothing.
-//     1.3 If current volume is HAVolume then check is there any PDB already
-//     existing among the current replica pools. If PDB exists then return
-//     that PDB name. If PDB doesn't exist then create new PDB and return newely
-//     created PDB name.
-//  2. Update CVC label to point it to newely PDB got from above step and also
-//     replicas pool information on status of CVC.
+//  1. Update CVC label to point it to the PDB of pools of CSPC if volume is
+//     HAVolume after scaling up/scaling down(case might be from 3 to 2
+//     replicas) else remove the label, along with the replicas pool
+//     information on status of CVC.
+//  2. Sync the PDB of pools of CSPC as per the scaled replicas.
 //
 // NOTE: This function return object as well as error if error occured
 func (c *CVCController) updatePDBForScaledVolume(cvc *apis.CStorVolumeConfig) (*apis.CStorVolumeConfig, error) {
-	var err error
 	cvcCopy := cvc.DeepCopy()
-	pdbName, err := c.getUpdatePDBForVolume(cvc)
-	if err != nil {
-		return cvcCopy, errors.Wrapf(err,
-			"failed to handle PDB for scaled volume %s",
-			cvc.Name,
-		)
-	}
 	delete(cvc.Labels, string(types.PodDisruptionBudgetKey))
-	if pdbName != "" {
-		cvc.Labels[string(types.PodDisruptionBudgetKey)] = pdbName
+	if isHAVolume(cvc) {
+		addPDBLabelOnCVC(cvc, getPoolPDBName(getCSPC(cvc)))
 	}
 	newCVCObj, err := c.clientset.CstorV1().CStorVolumeConfigs(openebsNamespace).Update(context.TODO(), cvc, metav1.UpdateOptions{})
 	if err != nil {
@@ -774,6 +849,11 @@ func (c *CVCController) updatePDBForScaledVolume(cvc *apis.CStorVolumeConfig) (*
 			cvc.Name,
 		)
 	}
+	// PDB is synced periodically as well, hence failure to sync it doesn't
+	// fail the scaling
+	if err := c.syncPoolPDB(getCSPC(newCVCObj)); err != nil {
+		klog.Errorf("failed to sync PDB of pools for scaled volume %s: %v", cvc.Name, err)
+	}
 	return newCVCObj, nil
 }
 
@@ -860,6 +940,12 @@ func (c *CVCController) handleVolumeReplicaCreation(cvc *apis.CStorVolumeConfig,
 			klog.Errorf("%s", errorMsg)
 			continue
 		}
+		if cspiutil.IsCordoned(cspiObj) {
+			errorMsg = fmt.Sprintf("cstorpoolinstance %s is cordoned, new replica can't be placed on it", poolName)
+			errs = append(errs, errors.Errorf("%v", errorMsg))
+			klog.Errorf("%s", errorMsg)
+			continue
+		}
 		hashVal, err := hash.Hash(pvName + "-" + poolName)
 		if err != nil {
 			errorMsg = fmt.Sprintf(
@@ -13,3 +13,124 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 package cstorvolumeconfig
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	apistypes "github.com/openebs/api/v3/pkg/apis/types"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
+	"github.com/openebs/cstor-operators/pkg/volumereplica"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSanitizePoolList(t *testing.T) {
+	offline := newMigrateCSPI("cspi-offline", "cspc", apis.CStorPoolStatusOffline)
+	readOnly := newMigrateCSPI("cspi-readonly", "cspc", apis.CStorPoolStatusOnline)
+	readOnly.Status.ReadOnly = true
+	cordoned := newMigrateCSPI("cspi-cordoned", "cspc", apis.CStorPoolStatusOnline)
+	cordoned.Annotations = map[string]string{cspiutil.CordonAnnotation: "true"}
+	uncordoned := newMigrateCSPI("cspi-uncordoned", "cspc", apis.CStorPoolStatusOnline)
+	uncordoned.Annotations = map[string]string{cspiutil.CordonAnnotation: "false"}
+	draining := newMigrateCSPI("cspi-draining", "cspc", apis.CStorPoolStatusOnline)
+	draining.Annotations = map[string]string{cspiutil.DrainAnnotation: "true"}
+	online := newMigrateCSPI("cspi-online", "cspc", apis.CStorPoolStatusOnline)
+
+	list := &apis.CStorPoolInstanceList{Items: []apis.CStorPoolInstance{
+		*offline, *readOnly, *cordoned, *uncordoned, *draining, *online,
+	}}
+	got := []string{}
+	for _, pool := range sanitizePoolList(list).Items {
+		got = append(got, pool.Name)
+	}
+	if strings.Join(got, ",") != "cspi-uncordoned,cspi-online" {
+		t.Errorf("expected pools cspi-uncordoned,cspi-online but got %v", got)
+	}
+}
+
+func TestDistributeCVRsSeed(t *testing.T) {
+	openebsNamespace = namespace
+	srcCVR := &apis.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pvc-src-cspi-1",
+			Namespace: namespace,
+			Labels: map[string]string{
+				pvSelector: "pvc-src",
+				string(apistypes.CStorPoolInstanceNameLabelKey): "cspi-1",
+			},
+		},
+		Status: apis.CStorVolumeReplicaStatus{
+			Phase:     apis.CVRStatusOnline,
+			Snapshots: map[string]apis.CStorSnapshotInfo{"snap1": {}},
+		},
+	}
+	claim := &apis.CStorVolumeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pvc-clone",
+			Namespace: namespace,
+			Labels:    map[string]string{string(apistypes.CStorPoolClusterLabelKey): "cspc"},
+		},
+		Spec: apis.CStorVolumeConfigSpec{
+			CStorVolumeSource: "pvc-src@snap1",
+			Provision: apis.VolumeProvision{
+				Capacity:     corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				ReplicaCount: 2,
+			},
+		},
+	}
+	f := newFixture(t)
+	f.openebsObjects = append(f.openebsObjects,
+		newMigrateCSPI("cspi-1", "cspc", apis.CStorPoolStatusOnline),
+		newMigrateCSPI("cspi-2", "cspc", apis.CStorPoolStatusOnline),
+		srcCVR,
+	)
+	f.SetFakeClient()
+	c, _, _, _ := f.newCVCController()
+	volume := &apis.CStorVolume{ObjectMeta: metav1.ObjectMeta{Name: "pvc-clone", Namespace: namespace}}
+
+	err := c.distributeCVRs(2, claim, &corev1.Service{}, volume, &apis.CStorVolumePolicy{})
+	if err != nil {
+		t.Fatalf("unexpected error distributing cvrs: %v", err)
+	}
+
+	cvrs := f.openebsClient.CstorV1().CStorVolumeReplicas(namespace)
+	local, err := cvrs.Get(context.TODO(), "pvc-clone-cspi-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src, ok := local.Annotations[volumereplica.SeedSourceKey]; ok {
+		t.Errorf("expected clone replica on pool of source to be cloned locally but is seeded from %s", src)
+	}
+	seeded, err := cvrs.Get(context.TODO(), "pvc-clone-cspi-2", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src := seeded.Annotations[volumereplica.SeedSourceKey]; src != srcCVR.Name {
+		t.Errorf("expected clone replica on other pool to be seeded from %s but got %q", srcCVR.Name, src)
+	}
+
+	src, err := cvrs.Get(context.TODO(), srcCVR.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := src.Annotations[volumereplica.SeedTokenKey]
+	if src.Annotations[volumereplica.SeedSnapshotKey] != "snap1" || token == "" {
+		t.Errorf("expected source replica to be requested to serve snap1 with a token but got %v", src.Annotations)
+	}
+	if src.Status.Phase != apis.CVRStatusOnline || len(src.Status.Snapshots) != 1 {
+		t.Errorf("expected status of source replica to be untouched but got %+v", src.Status)
+	}
+
+	// a pending request is reused by the next clone replicas
+	if _, err := c.getOrRequestSeedSource("pvc-src", "snap1"); err != nil {
+		t.Fatal(err)
+	}
+	src, _ = cvrs.Get(context.TODO(), srcCVR.Name, metav1.GetOptions{})
+	if src.Annotations[volumereplica.SeedTokenKey] != token {
+		t.Errorf("expected token of pending request to be kept")
+	}
+}
@@ -36,6 +36,7 @@ import (
 	openebsScheme "github.com/openebs/api/v3/pkg/client/clientset/versioned/scheme"
 	informers "github.com/openebs/api/v3/pkg/client/informers/externalversions"
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 )
 
 const replicaControllerName = "CStorVolumeReplica"
@@ -233,6 +234,7 @@ func NewCStorVolumeReplicaController(
 			},
 		})
 
+	health.RegisterController(replicaControllerName)
 	return controller
 }
 
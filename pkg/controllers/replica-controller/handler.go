@@ -29,9 +29,11 @@ import (
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
 	"github.com/openebs/cstor-operators/pkg/debug"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/version"
 	"github.com/openebs/cstor-operators/pkg/volumereplica"
 	errors "github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -293,6 +295,24 @@ func (c *CStorVolumeReplicaController) cVREventHandler(
 			delete(cvrObj.Annotations, volumereplica.IsRestoreVol)
 		}
 
+		// serve the snapshot stream if any clone replica placed on
+		// other pool is waiting for it
+		c.serveSeedRequest(cvrObj, fullVolName)
+
+		if cvrObj.Annotations[volumereplica.PromoteKey] == volumereplica.PromoteRequested {
+			err := volumereplica.PromoteVolume(fullVolName)
+			if err != nil {
+				c.recorder.Event(
+					cvrObj,
+					corev1.EventTypeWarning,
+					"PromoteFailed",
+					fmt.Sprintf("failed to promote volume replica error: %v", err.Error()),
+				)
+				return "", err
+			}
+			cvrObj.Annotations[volumereplica.PromoteKey] = volumereplica.PromoteCompleted
+		}
+
 		return c.getCVRStatus(cvrObj)
 	}
 
@@ -411,7 +431,12 @@ func (c *CStorVolumeReplicaController) cVRAddEventHandler(
 	//TODO: Follow best practice while refactor reconciliation logic
 
 	if isCVRCreateStatus(cVR) {
-		return c.createVolumeReplica(cVR, fullVolName)
+		// continues the provisioning trace of the CVC controller
+		_, span := tracing.Start(context.TODO(), cVR, "CreateVolumeReplica",
+			attribute.String("dataset", fullVolName))
+		phase, err := c.createVolumeReplica(cVR, fullVolName)
+		tracing.End(span, err)
+		return phase, err
 	}
 	return string(apis.CVRStatusOffline),
 		fmt.Errorf(
@@ -449,7 +474,12 @@ func (c *CStorVolumeReplicaController) createVolumeReplica(
 		return string(cVR.Status.Phase), errors.New("ReplicaID is not set")
 	}
 
-	err := volumereplica.CreateVolumeReplica(cVR, fullVolName, quorum)
+	var err error
+	if isSeededReplica(cVR) {
+		err = c.createSeededVolumeReplica(cVR, fullVolName, quorum)
+	} else {
+		err = volumereplica.CreateVolumeReplica(cVR, fullVolName, quorum)
+	}
 	if err != nil {
 		klog.Errorf("cVR creation failure: %v", err.Error())
 		c.recorder.Event(
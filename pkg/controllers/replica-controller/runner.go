@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 	errors "github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -43,6 +44,7 @@ func (c *CStorVolumeReplicaController) Run(threadiness int, stopCh <-chan struct
 	if ok := cache.WaitForCacheSync(stopCh, c.cStorReplicaSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
+	health.MarkSynced(replicaControllerName)
 
 	klog.Info("Starting CStorVolumeReplica workers")
 
@@ -83,6 +85,7 @@ func (c *CStorVolumeReplicaController) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	defer health.TrackWork(replicaControllerName)()
 
 	// We wrap this block in a func so we can defer c.workqueue.Done.
 	err := func(obj interface{}) error {
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumereplica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/volumereplica"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// seedServers tracks the source replicas of this pool which are currently
+// serving a snapshot stream along with the port they are listening on
+var seedServers = struct {
+	sync.Mutex
+	ports map[string]int
+}{ports: map[string]int{}}
+
+// isSeededReplica returns true if the CVR has to be created by receiving the
+// source snapshot from a peer pool instead of a local zfs clone
+func isSeededReplica(cVR *apis.CStorVolumeReplica) bool {
+	return cVR.GetAnnotations()[volumereplica.SeedSourceKey] != ""
+}
+
+// serveSeedRequest starts streaming the snapshot requested via
+// SeedSnapshotKey annotation to the clone replicas and updates the endpoint
+// annotation on the in-memory CVR. The stream is served in background and is
+// restarted on next sync while the request annotation exists.
+func (c *CStorVolumeReplicaController) serveSeedRequest(
+	cvr *apis.CStorVolumeReplica, fullVolName string) {
+	snapName := cvr.GetAnnotations()[volumereplica.SeedSnapshotKey]
+	if snapName == "" {
+		delete(cvr.Annotations, volumereplica.SeedEndpointKey)
+		return
+	}
+	token := cvr.GetAnnotations()[volumereplica.SeedTokenKey]
+	if token == "" {
+		klog.Errorf("can not serve snapshot %s of cvr %s: %s is not set",
+			snapName, cvr.Name, volumereplica.SeedTokenKey)
+		return
+	}
+
+	podIP := os.Getenv(string(common.OpenEBSIOPodIP))
+	if podIP == "" {
+		klog.Errorf("can not serve snapshot %s of cvr %s: %s is not set",
+			snapName, cvr.Name, common.OpenEBSIOPodIP)
+		return
+	}
+
+	seedServers.Lock()
+	defer seedServers.Unlock()
+	port, ok := seedServers.ports[cvr.Name]
+	if !ok {
+		port = getFreeSeedPort()
+		if port == 0 {
+			klog.Warningf("can not serve snapshot %s of cvr %s: all seed ports are in use",
+				snapName, cvr.Name)
+			return
+		}
+		seedServers.ports[cvr.Name] = port
+		go func(cvrName, namespace string) {
+			isPeerAllowed := func(ip string) bool {
+				return c.isSeedPeer(namespace, cvrName, ip)
+			}
+			err := volumereplica.ServeVolumeSeed(fullVolName, snapName, token, port, isPeerAllowed)
+			if err != nil {
+				klog.Errorf("failed to serve snapshot %s of cvr %s: %v", snapName, cvrName, err)
+			}
+			seedServers.Lock()
+			delete(seedServers.ports, cvrName)
+			seedServers.Unlock()
+		}(cvr.Name, cvr.Namespace)
+	}
+	cvr.Annotations[volumereplica.SeedEndpointKey] = net.JoinHostPort(podIP, strconv.Itoa(port))
+}
+
+// isSeedPeer returns true if the given ip belongs to the pool-manager of a
+// clone replica waiting to be seeded from the given source replica
+func (c *CStorVolumeReplicaController) isSeedPeer(namespace, srcCVRName, ip string) bool {
+	cvrList, err := c.clientset.CstorV1().CStorVolumeReplicas(namespace).
+		List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("failed to list clone replicas of cvr %s: %v", srcCVRName, err)
+		return false
+	}
+	for _, cvr := range cvrList.Items {
+		if cvr.Annotations[volumereplica.SeedSourceKey] != srcCVRName ||
+			!isCVRCreateStatus(&cvr) {
+			continue
+		}
+		poolName := cvr.GetLabels()[string(types.CStorPoolInstanceNameLabelKey)]
+		podList, err := c.kubeclientset.CoreV1().Pods(namespace).
+			List(context.TODO(), metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", types.CStorPoolInstanceLabelKey, poolName),
+			})
+		if err != nil {
+			klog.Errorf("failed to list pool pods of cvr %s: %v", cvr.Name, err)
+			continue
+		}
+		for _, pod := range podList.Items {
+			if pod.Status.PodIP == ip {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getFreeSeedPort returns a port which is not used by any other stream of
+// this pool or 0 if all ports are in use. Caller must hold the seedServers
+// lock.
+func getFreeSeedPort() int {
+	inUse := map[int]bool{}
+	for _, port := range seedServers.ports {
+		inUse[port] = true
+	}
+	for port := volumereplica.SeedPortStart; port < volumereplica.SeedPortStart+volumereplica.SeedPortCount; port++ {
+		if !inUse[port] {
+			return port
+		}
+	}
+	return 0
+}
+
+// createSeededVolumeReplica creates the clone volume replica by receiving the
+// source snapshot from the pool of the source replica
+func (c *CStorVolumeReplicaController) createSeededVolumeReplica(
+	cVR *apis.CStorVolumeReplica, fullVolName string, quorum bool) error {
+	srcCVRName := cVR.Annotations[volumereplica.SeedSourceKey]
+	srcCVR, err := c.clientset.CstorV1().CStorVolumeReplicas(cVR.Namespace).
+		Get(context.TODO(), srcCVRName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source cvr %s", srcCVRName)
+	}
+	endpoint := srcCVR.Annotations[volumereplica.SeedEndpointKey]
+	token := srcCVR.Annotations[volumereplica.SeedTokenKey]
+	if endpoint == "" || token == "" {
+		return errors.Errorf("source cvr %s is not serving snapshot %s yet",
+			srcCVRName, cVR.Annotations[string(apis.SnapshotNameKey)])
+	}
+
+	// receiving the whole volume can outlast the worker timeout of liveness
+	doneOp := health.TrackLongOperation(replicaControllerName)
+	err = volumereplica.ReceiveVolumeSeed(endpoint, token, fullVolName)
+	doneOp()
+	if err != nil {
+		return err
+	}
+	err = volumereplica.SetSeededVolumeProperties(cVR, fullVolName, quorum)
+	if err != nil {
+		return err
+	}
+
+	if err := c.releaseSeedSource(cVR, srcCVR); err != nil {
+		// Not releasing the source only keeps the stream around till the
+		// next attempt, hence don't fail the replica creation
+		klog.Errorf("failed to release seed source of cvr %s: %v", cVR.Name, err)
+	}
+	return nil
+}
+
+// releaseSeedSource removes the seed request from the source replica if no
+// other replica of the clone volume is still waiting for the stream
+func (c *CStorVolumeReplicaController) releaseSeedSource(
+	cVR, srcCVR *apis.CStorVolumeReplica) error {
+	volName := cVR.GetLabels()[types.PersistentVolumeLabelKey]
+	cvrList, err := c.clientset.CstorV1().CStorVolumeReplicas(cVR.Namespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", types.PersistentVolumeLabelKey, volName),
+		})
+	if err != nil {
+		return err
+	}
+	for _, cvr := range cvrList.Items {
+		if cvr.Name == cVR.Name ||
+			cvr.Annotations[volumereplica.SeedSourceKey] != srcCVR.Name {
+			continue
+		}
+		if isCVRCreateStatus(&cvr) {
+			return nil
+		}
+	}
+
+	// merge patch is used so that concurrent status updates of the source
+	// replica by its own pool-manager doesn't conflict with this request
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				volumereplica.SeedSnapshotKey: nil,
+				volumereplica.SeedEndpointKey: nil,
+				volumereplica.SeedTokenKey:    nil,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CstorV1().CStorVolumeReplicas(srcCVR.Namespace).
+		Patch(context.TODO(), srcCVR.Name, ktypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
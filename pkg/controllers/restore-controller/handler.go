@@ -25,7 +25,10 @@ import (
 	cstorapis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	"github.com/openebs/api/v3/pkg/apis/types"
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/volumereplica"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -117,7 +120,15 @@ func (c *RestoreController) syncEventHandler(rst *cstorapis.CStorRestore) (strin
 			return "", err
 		}
 
+		// continues the restore trace of the CVC server
+		_, span := tracing.Start(context.TODO(), rst, "CreateVolumeRestore",
+			attribute.String("volume", rst.Spec.VolumeName),
+			attribute.String("restore", rst.Spec.RestoreName))
+		// receiving the snapshot can outlast the worker timeout of liveness
+		doneOp := health.TrackLongOperation(restoreControllerName)
 		err = volumereplica.CreateVolumeRestore(rst)
+		doneOp()
+		tracing.End(span, err)
 		if err != nil {
 			klog.Errorf("restore creation failure: %v", err.Error())
 			return string(cstorapis.RSTCStorStatusFailed), err
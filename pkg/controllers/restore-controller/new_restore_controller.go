@@ -36,6 +36,7 @@ import (
 
 	cstorapis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	openebsScheme "github.com/openebs/api/v3/pkg/client/clientset/versioned/scheme"
@@ -149,6 +150,7 @@ func NewCStorRestoreController(
 
 		},
 	})
+	health.RegisterController(restoreControllerName)
 	return controller
 }
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/openebs/cstor-operators/pkg/controllers/common"
+	"github.com/openebs/cstor-operators/pkg/health"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -42,6 +43,7 @@ func (c *RestoreController) Run(threadiness int, stopCh <-chan struct{}) error {
 	if ok := cache.WaitForCacheSync(stopCh, c.RestoreSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
+	health.MarkSynced(restoreControllerName)
 
 	klog.V(1).Info("Starting CStorRestore workers")
 
@@ -73,6 +75,7 @@ func (c *RestoreController) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	defer health.TrackWork(restoreControllerName)()
 
 	// We wrap this block in a func so we can defer c.workqueue.Done.
 	err := func(obj interface{}) error {
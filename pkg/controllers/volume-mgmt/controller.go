@@ -33,6 +33,7 @@ import (
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	openebsScheme "github.com/openebs/api/v3/pkg/client/clientset/versioned/scheme"
 	informers "github.com/openebs/api/v3/pkg/client/informers/externalversions"
+	"github.com/openebs/cstor-operators/pkg/health"
 )
 
 // CStorVolumeController is the controller implementation for CStorVolume resources.
@@ -55,6 +56,10 @@ type CStorVolumeController struct {
 	// recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
 	recorder record.EventRecorder
+
+	// standby elects the active target pod when the volume runs a warm
+	// standby target, it is nil otherwise
+	standby *targetStandby
 }
 
 // NewCStorVolumeController returns a new instance of CStorVolume controller
@@ -133,5 +138,12 @@ func NewCStorVolumeController(
 		},
 	})
 
+	health.RegisterController(CStorVolume)
 	return controller
 }
+
+// isActiveTarget returns true if this target pod has to reconcile the
+// CStorVolume i.e it is the only target pod or it holds the target Lease
+func (c *CStorVolumeController) isActiveTarget() bool {
+	return c.standby == nil || c.standby.isActive()
+}
@@ -32,7 +32,9 @@ import (
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	"github.com/openebs/api/v3/pkg/util"
 	"github.com/openebs/cstor-operators/pkg/controllers/volume-mgmt/volume"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/version"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,6 +66,9 @@ func (c *CStorVolumeController) syncHandler(
 	if err != nil {
 		return err
 	}
+	if !c.isActiveTarget() {
+		return c.standby.syncStandbyTarget(cStorVolumeGot)
+	}
 	cStorVolumeGot, err = c.reconcileVersion(cStorVolumeGot)
 	if err != nil {
 		klog.Errorf("failed to upgrade cv %s:%s", cStorVolumeGot.Name, err.Error())
@@ -163,7 +168,10 @@ func (c *CStorVolumeController) cStorVolumeEventHandler(
 		//replication information
 		types.TargetNamespace = cStorVolumeGot.Namespace
 
+		// continues the provisioning trace of the CVC controller
+		_, span := tracing.Start(context.TODO(), cStorVolumeGot, "CreateVolumeTarget")
 		err = volume.CreateVolumeTarget(cStorVolumeGot)
+		tracing.End(span, err)
 		if err != nil {
 			return CVStatusError, err
 		}
@@ -632,7 +640,11 @@ func (c *CStorVolumeController) resizeCStorVolume(
 	conditionStatus := customCVObj.GetCVCondition(apis.CStorVolumeResizing)
 	desiredCap := copyCV.Spec.Capacity.String()
 
+	// continues the resize trace of the CVC controller
+	_, span := tracing.Start(context.TODO(), copyCV, "ResizeVolumeTarget",
+		attribute.String("capacity", desiredCap))
 	err = volume.ResizeTargetVolume(copyCV)
+	tracing.End(span, err)
 	if err != nil {
 		eventMessage = fmt.Sprintf(
 			"failed to resize cstorvolume from %s to %s error %v",
@@ -19,6 +19,7 @@ package volumemgmt
 import (
 	"fmt"
 
+	"github.com/openebs/cstor-operators/pkg/health"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -41,6 +42,7 @@ func (c *CStorVolumeController) Run(threadiness int, stopCh <-chan struct{}) err
 	if ok := cache.WaitForCacheSync(stopCh, c.cStorVolumeSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
+	health.MarkSynced(CStorVolume)
 	klog.Info("Starting CStorVolume workers")
 	// Launch worker to process CStorVolume resources
 	for i := 0; i < threadiness; i++ {
@@ -57,7 +59,11 @@ func (c *CStorVolumeController) Run(threadiness int, stopCh <-chan struct{}) err
 	// NOTE: CV status will be updated to OFFLINE even cStor target
 	// container alone get restarted but in this case, target(istgt) will be still in
 	// running state. This inconsistency will be resolved in subsequent reconciliations
-	c.markCVStatusToOffline()
+	// Only the active target pod reconciles the CV, standby target pod should
+	// not mark the volume offline while the other pod is serving IOs.
+	if c.isActiveTarget() {
+		c.markCVStatusToOffline()
+	}
 	klog.Info("Shutting down CStorVolume workers")
 
 	return nil
@@ -79,6 +85,7 @@ func (c *CStorVolumeController) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	defer health.TrackWork(CStorVolume)()
 
 	// We wrap this block in a func so we can defer c.workqueue.Done.
 	err := func(obj interface{}) error {
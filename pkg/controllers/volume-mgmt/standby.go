@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemgmt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	apis "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/cstor-operators/pkg/controllers/volume-mgmt/volume"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// targetRoleKey is the label on target pod which is used by the target
+	// service to select the active target pod
+	targetRoleKey = "openebs.io/target-role"
+	// targetRoleActive is the role of target pod holding the Lease
+	targetRoleActive = "active"
+	// targetRoleStandby is the role of target pod waiting for the Lease
+	targetRoleStandby = "standby"
+	// pvLabelKey is the label on target pod holding the volume name
+	pvLabelKey = "openebs.io/persistent-volume"
+
+	// target Lease timings are kept shorter than the node failure detection
+	// so that standby takes over before the replicas give up on the target
+	targetLeaseDuration = 10 * time.Second
+	targetRenewDeadline = 7 * time.Second
+	targetRetryPeriod   = 2 * time.Second
+
+	// istgtProcessName is the name of the target process killed on losing
+	// the Lease
+	istgtProcessName = "istgt"
+)
+
+// procRoot is the proc filesystem listing the processes of the target pod,
+// overridden in tests
+var procRoot = "/proc"
+
+// targetStandby elects the active target pod of a volume having warm standby
+// target. Both target pods configure istgt but only the active pod reconciles
+// the CStorVolume and is selected by the target service.
+type targetStandby struct {
+	kubeclientset kubernetes.Interface
+	namespace     string
+	podName       string
+	leaseName     string
+
+	// active is true while this pod holds the target Lease
+	active atomic.Bool
+	// lastConf is the istgt configuration last applied by standby
+	lastConf []byte
+}
+
+// isTargetStandbyEnabled returns true if this target pod is one of the
+// active/standby target pods of the volume
+func isTargetStandbyEnabled() bool {
+	return os.Getenv(string(OpenEBSIOTargetStandby)) == "true"
+}
+
+// newTargetStandby returns a targetStandby for this target pod
+func newTargetStandby(kubeclientset kubernetes.Interface) *targetStandby {
+	return &targetStandby{
+		kubeclientset: kubeclientset,
+		namespace:     os.Getenv("OPENEBS_NAMESPACE"),
+		podName:       os.Getenv("POD_NAME"),
+		leaseName:     os.Getenv(string(OpenEBSIOTargetLeaseName)),
+	}
+}
+
+// isActive returns true if this target pod holds the target Lease
+func (s *targetStandby) isActive() bool {
+	return s.active.Load()
+}
+
+// Run keeps participating in the target leader election until stopCh is
+// closed. Losing the Lease fences the target, moves the pod back to standby
+// role and the pod campaigns again for the Lease.
+func (s *targetStandby) Run(stopCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      s.leaseName,
+			Namespace: s.namespace,
+		},
+		Client: s.kubeclientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: s.podName,
+		},
+	}
+
+	for {
+		runCtx, runCancel := context.WithCancel(ctx)
+		leaderelection.RunOrDie(runCtx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   targetLeaseDuration,
+			RenewDeadline:   targetRenewDeadline,
+			RetryPeriod:     targetRetryPeriod,
+			ReleaseOnCancel: true,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(leaderCtx context.Context) {
+					// previous active target fences itself within the renew
+					// deadline of losing the Lease, waiting for a whole
+					// Lease duration ensures it has stopped serving before
+					// the target service switches to this pod
+					select {
+					case <-leaderCtx.Done():
+						return
+					case <-time.After(targetLeaseDuration):
+					}
+					err := s.takeOver()
+					if err != nil {
+						// without the role label target service doesn't
+						// select this pod, hence release the Lease so that
+						// the other target pod can take over
+						klog.Errorf("failed to take over as active target: %v", err)
+						runCancel()
+					}
+				},
+				OnStoppedLeading: s.onStoppedLeading,
+				OnNewLeader: func(identity string) {
+					klog.Infof("Target pod %s is the active target of lease %s", identity, s.leaseName)
+				},
+			},
+		})
+		runCancel()
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
+
+// takeOver takes over the target service endpoints by moving the active role
+// label from any previous active pod to this pod
+func (s *targetStandby) takeOver() error {
+	klog.Infof("Acquired target lease %s, taking over as active target", s.leaseName)
+	err := s.demoteOtherTargets()
+	if err != nil {
+		return err
+	}
+	err = s.setPodRole(s.podName, targetRoleActive)
+	if err != nil {
+		return errors.Wrapf(err, "failed to mark pod %s as active target", s.podName)
+	}
+	s.active.Store(true)
+	alertlog.Alert(alertlog.VolumeTargetFailoverSuccess, "CStor volume target pod took over as active target",
+		alertlog.Resource{APIVersion: "v1", Kind: "Pod", Name: s.podName, Namespace: s.namespace},
+		"lease", s.leaseName,
+	)
+	return nil
+}
+
+// onStoppedLeading fences the target and moves this pod back to standby role.
+// Pod partitioned from the API server can't remove its own role label, hence
+// the target is fenced first so that the initiators and replicas still
+// connected to this pod are dropped before the new active target serves.
+func (s *targetStandby) onStoppedLeading() {
+	wasActive := s.active.Swap(false)
+	klog.Infof("Lost target lease %s, moving to standby", s.leaseName)
+	if wasActive {
+		s.fenceTarget()
+	}
+	err := s.setPodRole(s.podName, targetRoleStandby)
+	if err != nil {
+		klog.Errorf("failed to mark pod %s as standby target: %v", s.podName, err)
+	}
+}
+
+// fenceTarget kills istgt of this pod dropping all the sessions of the
+// volume. Kubelet restarts istgt with the last applied configuration and
+// the pod continues as a standby.
+func (s *targetStandby) fenceTarget() {
+	podResource := alertlog.Resource{APIVersion: "v1", Kind: "Pod", Name: s.podName, Namespace: s.namespace}
+	err := killProcesses(istgtProcessName)
+	if err != nil {
+		alertlog.Alert(alertlog.VolumeTargetFenceFailure, "Failed to fence CStor volume target pod which lost the lease",
+			podResource,
+			"lease", s.leaseName,
+			"error", err.Error(),
+		)
+		return
+	}
+	alertlog.Alert(alertlog.VolumeTargetFenced, "Fenced CStor volume target pod which lost the lease",
+		podResource,
+		"lease", s.leaseName,
+	)
+}
+
+// killProcesses kills the processes of the given name visible in procRoot
+// and returns an error if none is killed
+func killProcesses(name string) error {
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list processes")
+	}
+	killed := 0
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		comm, err := os.ReadFile(filepath.Join(procRoot, entry.Name(), "comm"))
+		if err != nil || strings.TrimSpace(string(comm)) != name {
+			continue
+		}
+		err = syscall.Kill(pid, syscall.SIGKILL)
+		if err != nil {
+			return errors.Wrapf(err, "failed to kill %s process %d", name, pid)
+		}
+		klog.Infof("Killed %s process %d", name, pid)
+		killed++
+	}
+	if killed == 0 {
+		return errors.Errorf("no %s process found", name)
+	}
+	return nil
+}
+
+// demoteOtherTargets removes the active role from other target pods of the
+// volume. Pod running on a lost node can't relabel itself, hence the new
+// active target has to do it before the service switches to this pod.
+func (s *targetStandby) demoteOtherTargets() error {
+	pod, err := s.kubeclientset.CoreV1().Pods(s.namespace).
+		Get(context.TODO(), s.podName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get target pod %s", s.podName)
+	}
+	podList, err := s.kubeclientset.CoreV1().Pods(s.namespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s,%s=%s",
+				pvLabelKey, pod.GetLabels()[pvLabelKey],
+				targetRoleKey, targetRoleActive),
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list active target pods")
+	}
+	for _, p := range podList.Items {
+		if p.Name == s.podName {
+			continue
+		}
+		err = s.setPodRole(p.Name, targetRoleStandby)
+		if err != nil {
+			return errors.Wrapf(err, "failed to demote target pod %s", p.Name)
+		}
+		klog.Infof("Moved target pod %s to standby", p.Name)
+	}
+	return nil
+}
+
+// setPodRole sets the target role label on the given pod
+func (s *targetStandby) setPodRole(podName, role string) error {
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{
+				targetRoleKey: role,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.kubeclientset.CoreV1().Pods(s.namespace).
+		Patch(context.TODO(), podName, ktypes.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// syncStandbyTarget keeps the istgt of standby pod configured with the latest
+// volume spec so that it can serve IOs as soon as it becomes active. The
+// CStorVolume is left untouched as it is reconciled by the active pod.
+func (s *targetStandby) syncStandbyTarget(cStorVolume *apis.CStorVolume) error {
+	err := volume.CheckValidVolume(cStorVolume)
+	if err != nil {
+		return err
+	}
+	// targetserver waits for the namespace before serving the replication
+	// details of replicas connecting to this target
+	types.TargetNamespace = cStorVolume.Namespace
+
+	data, err := volume.CreateIstgtConf(cStorVolume)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(data, s.lastConf) {
+		return nil
+	}
+	err = volume.CreateVolumeTarget(cStorVolume)
+	if err != nil {
+		return err
+	}
+	s.lastConf = data
+	return nil
+}
@@ -31,6 +31,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/openebs/cstor-operators/pkg/controllers/volume-mgmt/volume"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
@@ -153,6 +155,12 @@ type Environment string
 const (
 	// OpenEBSIOCStorVolumeID is the environment variable specified in pod.
 	OpenEBSIOCStorVolumeID Environment = "OPENEBS_IO_CSTOR_VOLUME_ID"
+	// OpenEBSIOTargetStandby is the environment variable set to true when
+	// the volume runs a warm standby target pod.
+	OpenEBSIOTargetStandby Environment = "OPENEBS_IO_TARGET_STANDBY"
+	// OpenEBSIOTargetLeaseName is the environment variable holding the name
+	// of the Lease used to elect the active target pod.
+	OpenEBSIOTargetLeaseName Environment = "OPENEBS_IO_TARGET_LEASE_NAME"
 )
 
 // QueueOperation represents the type of operation on resource
@@ -207,6 +215,7 @@ func StartControllers(kubeconfig string) {
 	if err != nil {
 		klog.Fatalf("Error building kubernetes clientset: %s", err.Error())
 	}
+	alertlog.ForwardToEvents(kubeClient, "cstor-volume-manager")
 
 	openebsClient, err := clientset.NewForConfig(cfg)
 	if err != nil {
@@ -216,6 +225,10 @@ func StartControllers(kubeconfig string) {
 	volume.FileOperatorVar = util.RealFileOperator{}
 
 	volume.UnixSockVar = util.RealUnixSock{}
+	health.AddReadinessCheck("istgt", func() error {
+		_, err := volume.UnixSockVar.SendCommand(util.IstgtStatusCmd)
+		return err
+	})
 
 	// Blocking call for checking status of istgt running in cstor-volume container.
 	util.CheckForIscsi(volume.UnixSockVar)
@@ -232,6 +245,13 @@ func StartControllers(kubeconfig string) {
 	cStorVolumeController := NewCStorVolumeController(kubeClient, openebsClient, kubeInformerFactory,
 		openebsInformerFactory)
 
+	// Volumes having warm standby target run two target pods and only the
+	// pod holding the target Lease reconciles the CStorVolume.
+	if isTargetStandbyEnabled() {
+		cStorVolumeController.standby = newTargetStandby(kubeClient)
+		go cStorVolumeController.standby.Run(stopCh)
+	}
+
 	go kubeInformerFactory.Start(stopCh)
 	go openebsInformerFactory.Start(stopCh)
 
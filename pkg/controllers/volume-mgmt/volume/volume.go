@@ -125,14 +125,18 @@ func init() {
 	FileOperatorVar = util.RealFileOperator{}
 }
 
+// alertResource returns the resource of the alerts raised for the volume
+func alertResource(cStorVolume *apis.CStorVolume) alertlog.Resource {
+	return alertlog.NewResource(apis.SchemeGroupVersion.WithKind("CStorVolume"), cStorVolume)
+}
+
 // CreateVolumeTarget creates a new cStor volume istgt config.
 func CreateVolumeTarget(cStorVolume *apis.CStorVolume) error {
 	// create conf file
 	data, err := CreateIstgtConf(cStorVolume)
 	if err != nil {
-		alertlog.Logger.Errorw("",
-			"eventcode", "cstor.volume.target.create.failure",
-			"msg", "Failed to create CStor volume target",
+		alertlog.Alert(alertlog.VolumeTargetCreateFailure, "Failed to create CStor volume target",
+			alertResource(cStorVolume),
 			"rname", cStorVolume.Name,
 		)
 		return errors.Wrapf(err, "failed to create istgtconf file data")
@@ -149,9 +153,8 @@ func CreateVolumeTarget(cStorVolume *apis.CStorVolume) error {
 		klog.Info("Failed to refresh iscsi service with new configuration.")
 	}
 	klog.Info("Creating Iscsi Volume Successful")
-	alertlog.Logger.Infow("",
-		"eventcode", "cstor.volume.target.create.success",
-		"msg", "Successfully created CStor volume target",
+	alertlog.Alert(alertlog.VolumeTargetCreateSuccess, "Successfully created CStor volume target",
+		alertResource(cStorVolume),
 		"rname", cStorVolume.Name,
 	)
 	return nil
@@ -226,11 +229,10 @@ func ResizeTargetVolume(cStorVolume *apis.CStorVolume) error {
 	resizeCmd := getResizeCommand(cStorVolume)
 	sockResp, err := UnixSockVar.SendCommand(resizeCmd)
 	if err != nil {
-		alertlog.Logger.Errorw("",
-			"eventcode", "cstor.volume.target.resize.failure",
-			"msg", "Failed to resize CStor volume target",
+		alertlog.Alert(alertlog.VolumeTargetResizeFailure, "Failed to resize CStor volume target",
+			alertResource(cStorVolume),
 			"rname", cStorVolume.Name,
-			"capacity", cStorVolume.Spec.Capacity,
+			"capacity", cStorVolume.Spec.Capacity.String(),
 		)
 
 		return errors.Wrapf(
@@ -241,11 +243,10 @@ func ResizeTargetVolume(cStorVolume *apis.CStorVolume) error {
 	}
 	for _, resp := range sockResp {
 		if strings.Contains(resp, "ERR") {
-			alertlog.Logger.Errorw("",
-				"eventcode", "cstor.volume.target.resize.failure",
-				"msg", "Failed to resize CStor volume target",
+			alertlog.Alert(alertlog.VolumeTargetResizeFailure, "Failed to resize CStor volume target",
+				alertResource(cStorVolume),
 				"rname", cStorVolume.Name,
-				"capacity", cStorVolume.Spec.Capacity,
+				"capacity", cStorVolume.Spec.Capacity.String(),
 			)
 			return errors.Errorf(
 				"failed to execute istgt %s command on volume %s resp: %s",
@@ -260,11 +261,10 @@ func ResizeTargetVolume(cStorVolume *apis.CStorVolume) error {
 	err = FileOperatorVar.Updatefile(util.IstgtConfPath, updateStorageVal, "LUN0 Storage", 0644)
 	if err != nil {
 		types.ConfFileMutex.Unlock()
-		alertlog.Logger.Errorw("",
-			"eventcode", "cstor.volume.target.resize.failure",
-			"msg", "Failed to resize CStor volume target",
+		alertlog.Alert(alertlog.VolumeTargetResizeFailure, "Failed to resize CStor volume target",
+			alertResource(cStorVolume),
 			"rname", cStorVolume.Name,
-			"capacity", cStorVolume.Spec.Capacity,
+			"capacity", cStorVolume.Spec.Capacity.String(),
 		)
 		return errors.Wrapf(err,
 			"failed to update %s file with %s details",
@@ -273,11 +273,10 @@ func ResizeTargetVolume(cStorVolume *apis.CStorVolume) error {
 	}
 	types.ConfFileMutex.Unlock()
 	klog.Infof("Updated '%s' file with capacity '%s'", util.IstgtConfPath, updateStorageVal)
-	alertlog.Logger.Infow("",
-		"eventcode", "cstor.volume.target.resize.success",
-		"msg", "Successfully resized CStor volume target",
+	alertlog.Alert(alertlog.VolumeTargetResizeSuccess, "Successfully resized CStor volume target",
+		alertResource(cStorVolume),
 		"rname", cStorVolume.Name,
-		"capacity", cStorVolume.Spec.Capacity,
+		"capacity", cStorVolume.Spec.Capacity.String(),
 	)
 	return nil
 }
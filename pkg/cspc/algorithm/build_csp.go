@@ -19,6 +19,7 @@ package algorithm
 import (
 	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/cstor-operators/pkg/util/defaults"
 	"github.com/openebs/cstor-operators/pkg/version"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,7 +35,6 @@ const (
 // GetCSPSpec returns a CSPI spec that should be created and claims all the
 // block device present in the CSPI spec
 func (ac *Config) GetCSPISpec() (*cstor.CStorPoolInstance, error) {
-	defaultROThresholdLimit := 85
 	poolSpec, nodeName, err := ac.SelectNode()
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to select a node")
@@ -44,7 +44,8 @@ func (ac *Config) GetCSPISpec() (*cstor.CStorPoolInstance, error) {
 		return nil, errors.Errorf("failed to select a node as empty node name received from SelectNode")
 	}
 
-	// ToDo: Move following to mutating webhook.
+	// Defaults below depend on cspc level defaults and are left to the
+	// controller so that pools follow the changes to cspc level defaults.
 	if poolSpec.PoolConfig.Resources == nil {
 		poolSpec.PoolConfig.Resources = ac.CSPC.Spec.DefaultResources
 	}
@@ -60,9 +61,7 @@ func (ac *Config) GetCSPISpec() (*cstor.CStorPoolInstance, error) {
 		poolSpec.PoolConfig.PriorityClassName = &priorityClassName
 	}
 
-	if poolSpec.PoolConfig.ROThresholdLimit == nil {
-		poolSpec.PoolConfig.ROThresholdLimit = &defaultROThresholdLimit
-	}
+	defaults.SetPoolConfigDefaults(&poolSpec.PoolConfig)
 
 	cspiLabels := ac.buildLabelsForCSPI(nodeName)
 	cspiObj := cstor.NewCStorPoolInstance().
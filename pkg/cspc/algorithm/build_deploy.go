@@ -24,10 +24,14 @@ import (
 	deployapi "github.com/openebs/api/v3/pkg/kubernetes/apps"
 	coreapi "github.com/openebs/api/v3/pkg/kubernetes/core"
 	"github.com/openebs/api/v3/pkg/util"
+	"github.com/openebs/cstor-operators/pkg/health"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/openebs/cstor-operators/pkg/version"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -102,7 +106,11 @@ func (c *Config) GetPoolDeploySpec(cspi *cstor.CStorPoolInstance) *appsv1.Deploy
 						WithPrivilegedSecurityContext(&privileged).
 						WithEnvsNew(getPoolMgmtEnv(cspi)).
 						WithEnvs(getPoolUIDAsEnv(c.CSPC)).
+						WithEnvs(tracing.Env()).
+						WithEnvs(alertlog.Env()).
 						WithResources(getAuxResourceRequirement(cspi)).
+						WithPortsNew(getContainerPort(health.DefaultPort)).
+						WithLivenessProbe(getPoolMgmtLivenessProbe()).
 						WithVolumeMountsNew(getPoolMgmtMounts()),
 					coreapi.NewContainer().
 						WithImage(getPoolImage()).
@@ -168,6 +176,13 @@ func (c *Config) GetPoolDeploySpec(cspi *cstor.CStorPoolInstance) *appsv1.Deploy
 				),
 		).Build()
 
+	// container builder doesn't support readiness probe
+	for i := range deployObj.Spec.Template.Spec.Containers {
+		container := &deployObj.Spec.Template.Spec.Containers[i]
+		if container.Name == PoolMgmtContainerName {
+			container.ReadinessProbe = getPoolMgmtReadinessProbe()
+		}
+	}
 	return deployObj
 
 }
@@ -319,6 +334,14 @@ func getPoolMgmtEnv(cspi *cstor.CStorPoolInstance) []corev1.EnvVar {
 				},
 			},
 		},
+		corev1.EnvVar{
+			Name: "POD_IP",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "status.podIP",
+				},
+			},
+		},
 	)
 }
 
@@ -337,6 +360,40 @@ func getPoolLivenessProbe() *corev1.Probe {
 	return probe
 }
 
+// getPoolMgmtLivenessProbe returns the liveness probe of pool manager which
+// fails only if the manager is wedged, so that the manager container is
+// restarted without restarting the pool container
+func getPoolMgmtLivenessProbe() *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: health.LivenessPath,
+				Port: intstr.FromInt(health.DefaultPort),
+			},
+		},
+		FailureThreshold:    3,
+		InitialDelaySeconds: 30,
+		PeriodSeconds:       60,
+		TimeoutSeconds:      10,
+	}
+}
+
+// getPoolMgmtReadinessProbe returns the readiness probe of pool manager which
+// reflects informer sync and reachability of the pool i.e zrepl
+func getPoolMgmtReadinessProbe() *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: health.ReadinessPath,
+				Port: intstr.FromInt(health.DefaultPort),
+			},
+		},
+		FailureThreshold: 3,
+		PeriodSeconds:    30,
+		TimeoutSeconds:   10,
+	}
+}
+
 func getPoolMounts() []corev1.VolumeMount {
 	return getPoolMgmtMounts()
 }
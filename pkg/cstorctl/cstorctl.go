@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cstorctl implements the day-2 operations of the kubectl cstor
+// plugin. Every operation computes the spec changes of the CSPC or CVC,
+// previews them as a diff judged by the admission webhook via a server side
+// dry run and applies them unless it is a dry run.
+package cstorctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	"github.com/openebs/cstor-operators/pkg/webhook"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultNamespace is the namespace where OpenEBS is installed by default
+	DefaultNamespace = "openebs"
+
+	// pollInterval is the interval of polling the progress of operations
+	pollInterval = 5 * time.Second
+)
+
+// Client performs the operations on the cStor resources
+type Client struct {
+	kubeClient kubernetes.Interface
+	clientset  clientset.Interface
+	// namespace is the namespace where OpenEBS is installed
+	namespace string
+	// out receives the previews and progress of operations
+	out io.Writer
+	// dryRun only previews the changes without applying them
+	dryRun bool
+	// pollInterval is the interval of polling the progress of operations
+	pollInterval time.Duration
+}
+
+// NewClient returns a client performing the operations in the namespace
+// where OpenEBS is installed
+func NewClient(kubeClient kubernetes.Interface, clientset clientset.Interface, namespace string) *Client {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return &Client{
+		kubeClient:   kubeClient,
+		clientset:    clientset,
+		namespace:    namespace,
+		out:          io.Discard,
+		pollInterval: pollInterval,
+	}
+}
+
+// WithOutput sets the writer receiving the previews and progress
+func (c *Client) WithOutput(out io.Writer) *Client {
+	c.out = out
+	return c
+}
+
+// WithDryRun sets whether the changes are only previewed
+func (c *Client) WithDryRun(dryRun bool) *Client {
+	c.dryRun = dryRun
+	return c
+}
+
+// updateCSPC previews the changes from old to new CSPC and applies them
+// unless it is a dry run
+func (c *Client) updateCSPC(old, new *cstor.CStorPoolCluster) error {
+	if err := c.preview("cstorpoolcluster", old.Name, old.Spec, new.Spec); err != nil {
+		return err
+	}
+	cspcs := c.clientset.CstorV1().CStorPoolClusters(new.Namespace)
+	// admission webhook judges the changes on dry run without claiming
+	// the new blockdevices
+	_, err := cspcs.Update(context.TODO(), new, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return errors.Wrapf(err, "cstorpoolcluster %s changes are rejected", new.Name)
+	}
+	if c.dryRun {
+		fmt.Fprintf(c.out, "cstorpoolcluster/%s changes are valid (dry run)\n", new.Name)
+		return nil
+	}
+	if _, err := cspcs.Update(context.TODO(), new, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to update cstorpoolcluster %s", new.Name)
+	}
+	fmt.Fprintf(c.out, "cstorpoolcluster/%s updated\n", new.Name)
+	return nil
+}
+
+// updateCVC previews the changes from old to new CVC and applies them
+// unless it is a dry run
+func (c *Client) updateCVC(old, new *cstor.CStorVolumeConfig) error {
+	if err := c.preview("cstorvolumeconfig", old.Name, old.Spec.Policy.ReplicaPoolInfo,
+		new.Spec.Policy.ReplicaPoolInfo); err != nil {
+		return err
+	}
+	// CVC changes are validated before sending them so that the request is
+	// rejected even if the admission webhook ignores failures
+	if err := webhook.ValidateCVCSpecChanges(old, new); err != nil {
+		return errors.Wrapf(err, "cstorvolumeconfig %s changes are invalid", new.Name)
+	}
+	cvcs := c.clientset.CstorV1().CStorVolumeConfigs(new.Namespace)
+	_, err := cvcs.Update(context.TODO(), new, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return errors.Wrapf(err, "cstorvolumeconfig %s changes are rejected", new.Name)
+	}
+	if c.dryRun {
+		fmt.Fprintf(c.out, "cstorvolumeconfig/%s changes are valid (dry run)\n", new.Name)
+		return nil
+	}
+	if _, err := cvcs.Update(context.TODO(), new, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to update cstorvolumeconfig %s", new.Name)
+	}
+	fmt.Fprintf(c.out, "cstorvolumeconfig/%s updated\n", new.Name)
+	return nil
+}
+
+// preview writes the diff of the YAML of old and new
+func (c *Client) preview(kind, name string, old, new interface{}) error {
+	oldYAML, err := yaml.Marshal(old)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode %s %s", kind, name)
+	}
+	newYAML, err := yaml.Marshal(new)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode %s %s", kind, name)
+	}
+	fmt.Fprintf(c.out, "--- %s/%s\n+++ %s/%s\n%s", kind, name, kind, name,
+		Diff(string(oldYAML), string(newYAML)))
+	return nil
+}
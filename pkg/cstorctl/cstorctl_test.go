@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorctl
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	openebsFakeClientset "github.com/openebs/api/v3/pkg/client/clientset/versioned/fake"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func raidGroup(bds ...string) cstor.RaidGroup {
+	rg := cstor.RaidGroup{}
+	for _, bd := range bds {
+		rg.CStorPoolInstanceBlockDevices = append(rg.CStorPoolInstanceBlockDevices,
+			cstor.CStorPoolInstanceBlockDevice{BlockDeviceName: bd})
+	}
+	return rg
+}
+
+func poolSpec(node, rgType string, rgs ...cstor.RaidGroup) cstor.PoolSpec {
+	return cstor.PoolSpec{
+		NodeSelector:   map[string]string{types.HostNameLabelKey: node},
+		PoolConfig:     cstor.PoolConfig{DataRaidGroupType: rgType},
+		DataRaidGroups: rgs,
+	}
+}
+
+func newCSPC(pools ...cstor.PoolSpec) *cstor.CStorPoolCluster {
+	return &cstor.CStorPoolCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cspc", Namespace: DefaultNamespace},
+		Spec:       cstor.CStorPoolClusterSpec{Pools: pools},
+	}
+}
+
+func newCVC(phase cstor.CStorVolumeConfigPhase, pools ...string) *cstor.CStorVolumeConfig {
+	cvc := &cstor.CStorVolumeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: DefaultNamespace},
+		Status:     cstor.CStorVolumeConfigStatus{Phase: phase, PoolInfo: pools},
+	}
+	cvc.Spec.Provision.ReplicaCount = len(pools)
+	for _, pool := range pools {
+		cvc.Spec.Policy.ReplicaPoolInfo = append(cvc.Spec.Policy.ReplicaPoolInfo,
+			cstor.ReplicaPoolInfo{PoolName: pool})
+	}
+	return cvc
+}
+
+func TestReplaceBlockDevice(t *testing.T) {
+	cspc := newCSPC(
+		poolSpec("node-1", "mirror", raidGroup("bd-1", "bd-2")),
+		poolSpec("node-2", "mirror", raidGroup("bd-3", "bd-4")),
+	)
+	newCSPC, err := ReplaceBlockDevice(cspc, "bd-4", "bd-5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := blockDeviceNames(newCSPC.Spec.Pools[1].DataRaidGroups[0]); got != "bd-3, bd-5" {
+		t.Errorf("expected bd-4 to be replaced by bd-5 but got %s", got)
+	}
+	if got := blockDeviceNames(cspc.Spec.Pools[1].DataRaidGroups[0]); got != "bd-3, bd-4" {
+		t.Errorf("expected existing cspc to be unmodified but got %s", got)
+	}
+
+	for name, bds := range map[string][2]string{
+		"unknown blockdevice":      {"bd-9", "bd-5"},
+		"blockdevice already used": {"bd-1", "bd-3"},
+		"replaced by itself":       {"bd-1", "bd-1"},
+	} {
+		if _, err := ReplaceBlockDevice(cspc, bds[0], bds[1]); err == nil {
+			t.Errorf("%s: expected error replacing %s by %s", name, bds[0], bds[1])
+		}
+	}
+}
+
+func TestExpandPoolSpec(t *testing.T) {
+	cspi := &cstor.CStorPoolInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "cspc-x1"},
+		Spec: cstor.CStorPoolInstanceSpec{
+			NodeSelector: map[string]string{types.HostNameLabelKey: "node-2"},
+		},
+	}
+	stripe := newCSPC(
+		poolSpec("node-1", "stripe", raidGroup("bd-1")),
+		poolSpec("node-2", "stripe", raidGroup("bd-2")),
+	)
+	expanded, err := ExpandPoolSpec(stripe, cspi, [][]string{{"bd-3"}, {"bd-4"}}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rgs := expanded.Spec.Pools[1].DataRaidGroups
+	if len(rgs) != 1 || blockDeviceNames(rgs[0]) != "bd-2, bd-3, bd-4" {
+		t.Errorf("expected blockdevices added to stripe raid group but got %+v", rgs)
+	}
+
+	mirror := newCSPC(poolSpec("node-2", "mirror", raidGroup("bd-1", "bd-2")))
+	expanded, err = ExpandPoolSpec(mirror, cspi, [][]string{{"bd-3", "bd-4"}}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rgs = expanded.Spec.Pools[0].DataRaidGroups
+	if len(rgs) != 2 || blockDeviceNames(rgs[1]) != "bd-3, bd-4" {
+		t.Errorf("expected new mirror raid group but got %+v", rgs)
+	}
+
+	if _, err := ExpandPoolSpec(mirror, cspi, [][]string{{"bd-2", "bd-5"}}, false); err == nil {
+		t.Errorf("expected error expanding with a blockdevice of the pool")
+	}
+	if _, err := ExpandPoolSpec(mirror, cspi, [][]string{{"bd-5", "bd-6"}}, true); err == nil {
+		t.Errorf("expected error expanding write cache of pool without write cache")
+	}
+	cspi.Spec.NodeSelector = map[string]string{types.HostNameLabelKey: "node-9"}
+	if _, err := ExpandPoolSpec(mirror, cspi, [][]string{{"bd-5", "bd-6"}}, false); err == nil {
+		t.Errorf("expected error expanding pool missing in cspc")
+	}
+}
+
+func TestScaleReplicaPools(t *testing.T) {
+	bound := cstor.CStorVolumeConfigPhaseBound
+	scaled, err := ScaleReplicaPools(newCVC(bound, "cspi-1", "cspi-2"), []string{"cspi-3"}, []string{"cspi-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := scaled.GetDesiredReplicaPoolNames(); !reflect.DeepEqual(got, []string{"cspi-2", "cspi-3"}) {
+		t.Errorf("expected replica pools cspi-2, cspi-3 but got %v", got)
+	}
+
+	scaling := newCVC(bound, "cspi-1", "cspi-2")
+	scaling.Status.PoolInfo = []string{"cspi-1"}
+	tests := map[string]struct {
+		cvc               *cstor.CStorVolumeConfig
+		add, remove       []string
+		expectedErrSubstr string
+	}{
+		"nothing to scale":        {newCVC(bound, "cspi-1"), nil, nil, "no pools"},
+		"remove two replicas":     {newCVC(bound, "cspi-1", "cspi-2", "cspi-3"), nil, []string{"cspi-1", "cspi-2"}, "one replica"},
+		"volume not bound":        {newCVC(cstor.CStorVolumeConfigPhasePending, "cspi-1"), []string{"cspi-2"}, nil, "Pending"},
+		"scaling in progress":     {scaling, []string{"cspi-3"}, nil, "in progress"},
+		"replica already on pool": {newCVC(bound, "cspi-1"), []string{"cspi-1"}, nil, "already has"},
+		"no replica on pool":      {newCVC(bound, "cspi-1"), nil, []string{"cspi-2"}, "no replica"},
+	}
+	for name, test := range tests {
+		_, err := ScaleReplicaPools(test.cvc, test.add, test.remove)
+		if err == nil || !strings.Contains(err.Error(), test.expectedErrSubstr) {
+			t.Errorf("%s: expected error containing %q but got %v", name, test.expectedErrSubstr, err)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	got := Diff("a\nb\nc\n", "a\nx\nc\nd\n")
+	expected := " a\n-b\n+x\n c\n+d\n"
+	if got != expected {
+		t.Errorf("expected diff %q but got %q", expected, got)
+	}
+}
+
+func TestMigrateReplica(t *testing.T) {
+	cvc := newCVC(cstor.CStorVolumeConfigPhaseBound, "cspi-1", "cspi-2")
+	cvc.Annotations = map[string]string{"openebs.io/volumeID": "pvc-1"}
+	clientset := openebsFakeClientset.NewSimpleClientset(cvc)
+	// operator reports the progress of the migration on every poll and
+	// finishes it on the third poll
+	polls := 0
+	clientset.PrependReactor("get", "cstorvolumeconfigs",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			obj, err := clientset.Tracker().Get(action.GetResource(), DefaultNamespace, "pvc-1")
+			if err != nil {
+				return true, nil, err
+			}
+			current := obj.(*cstor.CStorVolumeConfig)
+			if current.Annotations[cvcutil.MigrateReplicaAnnotation] == "" {
+				return false, nil, nil
+			}
+			polls++
+			current.Status.Conditions = []cstor.CStorVolumeConfigCondition{{
+				Type:    cvcutil.CStorVolumeConfigMigrating,
+				Message: fmt.Sprintf("step %d", polls),
+			}}
+			if polls == 3 {
+				current, _ = ScaleReplicaPools(current, []string{"cspi-3"}, []string{"cspi-1"})
+				current.Status.PoolInfo = current.GetDesiredReplicaPoolNames()
+				current.Status.Conditions = nil
+				delete(current.Annotations, cvcutil.MigrateReplicaAnnotation)
+			}
+			return true, current, clientset.Tracker().Update(action.GetResource(), current, DefaultNamespace)
+		})
+
+	var out bytes.Buffer
+	client := NewClient(fake.NewSimpleClientset(), clientset, "").WithOutput(&out)
+	client.pollInterval = time.Millisecond
+	if err := client.MigrateReplica("pvc-1", "cspi-1", "cspi-3", time.Second); err != nil {
+		t.Fatalf("unexpected error: %v\n%s", err, out.String())
+	}
+
+	for _, expected := range []string{"+  " + cvcutil.MigrateReplicaAnnotation + ": cspi-1:cspi-3", "step 2", "replica migrated"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected output to contain %q but got:\n%s", expected, out.String())
+		}
+	}
+
+	if err := client.MigrateReplica("pvc-1", "cspi-2", "cspi-3", time.Second); err == nil {
+		t.Errorf("expected error migrating replica to pool having a replica")
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorctl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/pkg/errors"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplaceDisk replaces the blockdevice of a raid group in the CSPC with the
+// new blockdevice. The pool manager resilvers the data on to the new
+// blockdevice and releases the old one.
+func (c *Client) ReplaceDisk(cspcName, oldBD, newBD string) error {
+	cspc, err := c.clientset.CstorV1().CStorPoolClusters(c.namespace).
+		Get(context.TODO(), cspcName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cstorpoolcluster %s", cspcName)
+	}
+	newCSPC, err := ReplaceBlockDevice(cspc, oldBD, newBD)
+	if err != nil {
+		return err
+	}
+	return c.updateCSPC(cspc, newCSPC)
+}
+
+// ReplaceBlockDevice returns a copy of the CSPC where the old blockdevice is
+// replaced by the new blockdevice
+func ReplaceBlockDevice(cspc *cstor.CStorPoolCluster, oldBD, newBD string) (*cstor.CStorPoolCluster, error) {
+	if oldBD == newBD {
+		return nil, errors.Errorf("blockdevice %s can't be replaced by itself", oldBD)
+	}
+	if isBlockDeviceUsed(cspc, newBD) {
+		return nil, errors.Errorf("blockdevice %s is already used by cstorpoolcluster %s", newBD, cspc.Name)
+	}
+	newCSPC := cspc.DeepCopy()
+	for i := range newCSPC.Spec.Pools {
+		poolSpec := &newCSPC.Spec.Pools[i]
+		for _, rgs := range [][]cstor.RaidGroup{poolSpec.DataRaidGroups, poolSpec.WriteCacheRaidGroups} {
+			for _, rg := range rgs {
+				for j, bd := range rg.CStorPoolInstanceBlockDevices {
+					if bd.BlockDeviceName != oldBD {
+						continue
+					}
+					// capacity and devlink of the new blockdevice are filled
+					// by the operator
+					rg.CStorPoolInstanceBlockDevices[j] = cstor.CStorPoolInstanceBlockDevice{
+						BlockDeviceName: newBD,
+					}
+					return newCSPC, nil
+				}
+			}
+		}
+	}
+	return nil, errors.Errorf("blockdevice %s is not used by cstorpoolcluster %s", oldBD, cspc.Name)
+}
+
+// ExpandPool adds the blockdevices to the pool of the CSPI. The blockdevices
+// are added to the raid group of stripe pools, every group of blockdevices
+// forms a new raid group of other pools.
+func (c *Client) ExpandPool(cspiName string, bdGroups [][]string, writeCache bool) error {
+	cspi, err := c.clientset.CstorV1().CStorPoolInstances(c.namespace).
+		Get(context.TODO(), cspiName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cstorpoolinstance %s", cspiName)
+	}
+	cspcName := cspi.Labels[types.CStorPoolClusterLabelKey]
+	cspc, err := c.clientset.CstorV1().CStorPoolClusters(c.namespace).
+		Get(context.TODO(), cspcName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cstorpoolcluster %s of %s", cspcName, cspiName)
+	}
+	newCSPC, err := ExpandPoolSpec(cspc, cspi, bdGroups, writeCache)
+	if err != nil {
+		return err
+	}
+	return c.updateCSPC(cspc, newCSPC)
+}
+
+// ExpandPoolSpec returns a copy of the CSPC where the blockdevices are added
+// to the pool spec of the CSPI
+func ExpandPoolSpec(cspc *cstor.CStorPoolCluster, cspi *cstor.CStorPoolInstance,
+	bdGroups [][]string, writeCache bool) (*cstor.CStorPoolCluster, error) {
+	if len(bdGroups) == 0 {
+		return nil, errors.Errorf("no blockdevices to expand pool %s", cspi.Name)
+	}
+	for _, bds := range bdGroups {
+		for _, bd := range bds {
+			if isBlockDeviceUsed(cspc, bd) {
+				return nil, errors.Errorf("blockdevice %s is already used by cstorpoolcluster %s", bd, cspc.Name)
+			}
+		}
+	}
+	newCSPC := cspc.DeepCopy()
+	poolSpec := getPoolSpecOfCSPI(newCSPC, cspi)
+	if poolSpec == nil {
+		return nil, errors.Errorf("pool spec of %s not found in cstorpoolcluster %s", cspi.Name, cspc.Name)
+	}
+
+	rgType := poolSpec.PoolConfig.DataRaidGroupType
+	raidGroups := &poolSpec.DataRaidGroups
+	if writeCache {
+		rgType = poolSpec.PoolConfig.WriteCacheGroupType
+		raidGroups = &poolSpec.WriteCacheRaidGroups
+		if rgType == "" {
+			return nil, errors.Errorf("pool %s has no write cache raid group type", cspi.Name)
+		}
+	}
+
+	if rgType == string(cstor.PoolStriped) && len(*raidGroups) > 0 {
+		// stripe pools are expanded by adding blockdevices to the raid group
+		rg := &(*raidGroups)[0]
+		for _, bds := range bdGroups {
+			for _, bd := range bds {
+				rg.CStorPoolInstanceBlockDevices = append(rg.CStorPoolInstanceBlockDevices,
+					cstor.CStorPoolInstanceBlockDevice{BlockDeviceName: bd})
+			}
+		}
+		return newCSPC, nil
+	}
+	for _, bds := range bdGroups {
+		rg := cstor.RaidGroup{}
+		for _, bd := range bds {
+			rg.CStorPoolInstanceBlockDevices = append(rg.CStorPoolInstanceBlockDevices,
+				cstor.CStorPoolInstanceBlockDevice{BlockDeviceName: bd})
+		}
+		*raidGroups = append(*raidGroups, rg)
+	}
+	return newCSPC, nil
+}
+
+// getPoolSpecOfCSPI returns the pool spec of CSPC from which the CSPI is
+// provisioned, pool specs are identified by their node selector
+func getPoolSpecOfCSPI(cspc *cstor.CStorPoolCluster, cspi *cstor.CStorPoolInstance) *cstor.PoolSpec {
+	for i := range cspc.Spec.Pools {
+		if reflect.DeepEqual(cspc.Spec.Pools[i].NodeSelector, cspi.Spec.NodeSelector) {
+			return &cspc.Spec.Pools[i]
+		}
+	}
+	return nil
+}
+
+// isBlockDeviceUsed returns true if the blockdevice is part of any pool
+// spec of the CSPC
+func isBlockDeviceUsed(cspc *cstor.CStorPoolCluster, bdName string) bool {
+	for _, poolSpec := range cspc.Spec.Pools {
+		for _, rgs := range [][]cstor.RaidGroup{poolSpec.DataRaidGroups, poolSpec.WriteCacheRaidGroups} {
+			for _, rg := range rgs {
+				for _, bd := range rg.CStorPoolInstanceBlockDevices {
+					if bd.BlockDeviceName == bdName {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// DescribePool writes the details of the CSPC, or of the CSPC of the CSPI,
+// along with its pool instances
+func (c *Client) DescribePool(name string) error {
+	cspc, err := c.clientset.CstorV1().CStorPoolClusters(c.namespace).
+		Get(context.TODO(), name, metav1.GetOptions{})
+	if k8serror.IsNotFound(err) {
+		cspi, cspiErr := c.clientset.CstorV1().CStorPoolInstances(c.namespace).
+			Get(context.TODO(), name, metav1.GetOptions{})
+		if cspiErr != nil {
+			return errors.Errorf("no cstorpoolcluster or cstorpoolinstance named %s found", name)
+		}
+		cspc, err = c.clientset.CstorV1().CStorPoolClusters(c.namespace).
+			Get(context.TODO(), cspi.Labels[types.CStorPoolClusterLabelKey], metav1.GetOptions{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cstorpoolcluster %s", name)
+	}
+	cspiList, err := c.clientset.CstorV1().CStorPoolInstances(c.namespace).
+		List(context.TODO(), metav1.ListOptions{LabelSelector: types.CStorPoolClusterLabelKey + "=" + cspc.Name})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cstorpoolinstances of %s", cspc.Name)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", cspc.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", cspc.Namespace)
+	fmt.Fprintf(w, "Instances:\t%d desired, %d provisioned, %d healthy\n",
+		cspc.Status.DesiredInstances, cspc.Status.ProvisionedInstances, cspc.Status.HealthyInstances)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "POOL\tNODE\tPHASE\tREADONLY\tFREE\tUSED\tTOTAL\tREPLICAS\tHEALTHY")
+	for _, cspi := range cspiList.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\t%s\t%d\t%d\n",
+			cspi.Name, cspi.Spec.HostName, cspi.Status.Phase, cspi.Status.ReadOnly,
+			cspi.Status.Capacity.Free.String(), cspi.Status.Capacity.Used.String(),
+			cspi.Status.Capacity.Total.String(),
+			cspi.Status.ProvisionedReplicas, cspi.Status.HealthyReplicas)
+	}
+	for _, cspi := range cspiList.Items {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s (%s):\n", cspi.Name, cspi.Spec.PoolConfig.DataRaidGroupType)
+		for i, rg := range cspi.Spec.DataRaidGroups {
+			fmt.Fprintf(w, "  data-%d:\t%s\n", i, blockDeviceNames(rg))
+		}
+		for i, rg := range cspi.Spec.WriteCacheRaidGroups {
+			fmt.Fprintf(w, "  writecache-%d:\t%s\n", i, blockDeviceNames(rg))
+		}
+		for _, cond := range cspi.Status.Conditions {
+			fmt.Fprintf(w, "  %s=%s:\t%s\n", cond.Type, cond.Status, cond.Message)
+		}
+	}
+	return w.Flush()
+}
+
+func blockDeviceNames(rg cstor.RaidGroup) string {
+	names := make([]string, 0, len(rg.CStorPoolInstanceBlockDevices))
+	for _, bd := range rg.CStorPoolInstanceBlockDevices {
+		names = append(names, bd.BlockDeviceName)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/api/v3/pkg/util"
+	cvcutil "github.com/openebs/cstor-operators/pkg/controllers/cstorvolumeconfig/util"
+	"github.com/pkg/errors"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// cspiHostNameAnnotation holds the node of the pool on CVRs
+const cspiHostNameAnnotation = "cstorpoolinstance.openebs.io/hostname"
+
+// ScaleReplicas adds volume replicas on the pools to add and removes the
+// volume replica from the pool to remove
+func (c *Client) ScaleReplicas(pvName string, addPools, removePools []string) error {
+	cvc, err := c.getCVC(pvName)
+	if err != nil {
+		return err
+	}
+	newCVC, err := ScaleReplicaPools(cvc, addPools, removePools)
+	if err != nil {
+		return err
+	}
+	return c.updateCVC(cvc, newCVC)
+}
+
+// ScaleReplicaPools returns a copy of the CVC where the pools to add are
+// added to and the pools to remove are removed from the replica pools
+func ScaleReplicaPools(cvc *cstor.CStorVolumeConfig, addPools, removePools []string) (*cstor.CStorVolumeConfig, error) {
+	if len(addPools) == 0 && len(removePools) == 0 {
+		return nil, errors.Errorf("no pools to add or remove replicas of volume %s", cvc.Name)
+	}
+	if len(removePools) > 1 {
+		return nil, errors.Errorf("only one replica can be removed at a time, requested %v", removePools)
+	}
+	if cvc.Status.Phase != cstor.CStorVolumeConfigPhaseBound {
+		return nil, errors.Errorf("volume %s is %s, replicas can be scaled only when it is %s",
+			cvc.Name, cvc.Status.Phase, cstor.CStorVolumeConfigPhaseBound)
+	}
+	if len(cvc.Spec.Policy.ReplicaPoolInfo) != len(cvc.Status.PoolInfo) {
+		return nil, errors.Errorf("scaling of volume %s replicas is already in progress", cvc.Name)
+	}
+
+	newCVC := cvc.DeepCopy()
+	poolNames := cvc.GetDesiredReplicaPoolNames()
+	for _, pool := range addPools {
+		if util.ContainsString(poolNames, pool) {
+			return nil, errors.Errorf("volume %s already has a replica on pool %s", cvc.Name, pool)
+		}
+		newCVC.Spec.Policy.ReplicaPoolInfo = append(newCVC.Spec.Policy.ReplicaPoolInfo,
+			cstor.ReplicaPoolInfo{PoolName: pool})
+		poolNames = append(poolNames, pool)
+	}
+	for _, pool := range removePools {
+		if !util.ContainsString(poolNames, pool) {
+			return nil, errors.Errorf("volume %s has no replica on pool %s", cvc.Name, pool)
+		}
+		replicaPools := []cstor.ReplicaPoolInfo{}
+		for _, info := range newCVC.Spec.Policy.ReplicaPoolInfo {
+			if info.PoolName != pool {
+				replicaPools = append(replicaPools, info)
+			}
+		}
+		newCVC.Spec.Policy.ReplicaPoolInfo = replicaPools
+	}
+	return newCVC, nil
+}
+
+// MigrateReplica requests the CVC operator to move the volume replica from one
+// pool to another and waits up to the timeout for the migration to finish.
+// The operator adds the replica on the new pool first and removes the replica
+// on the old pool once the new replica is rebuilt and healthy.
+func (c *Client) MigrateReplica(pvName, fromPool, toPool string, timeout time.Duration) error {
+	cvc, err := c.getCVC(pvName)
+	if err != nil {
+		return err
+	}
+	if value := cvc.GetAnnotations()[cvcutil.MigrateReplicaAnnotation]; value != "" {
+		return errors.Errorf("migration %s of volume %s replica is already in progress", value, pvName)
+	}
+	poolNames := cvc.GetDesiredReplicaPoolNames()
+	if !util.ContainsString(poolNames, fromPool) {
+		return errors.Errorf("volume %s has no replica on pool %s", pvName, fromPool)
+	}
+	if util.ContainsString(poolNames, toPool) {
+		return errors.Errorf("volume %s already has a replica on pool %s", pvName, toPool)
+	}
+
+	newCVC := cvc.DeepCopy()
+	if newCVC.Annotations == nil {
+		newCVC.Annotations = map[string]string{}
+	}
+	newCVC.Annotations[cvcutil.MigrateReplicaAnnotation] = fromPool + ":" + toPool
+	if err := c.preview("cstorvolumeconfig", pvName,
+		annotationsOf(cvc.Annotations), annotationsOf(newCVC.Annotations)); err != nil {
+		return err
+	}
+	cvcs := c.clientset.CstorV1().CStorVolumeConfigs(c.namespace)
+	_, err = cvcs.Update(context.TODO(), newCVC, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return errors.Wrapf(err, "migration of volume %s replica is rejected", pvName)
+	}
+	if c.dryRun {
+		fmt.Fprintf(c.out, "cstorvolumeconfig/%s migration is valid (dry run)\n", pvName)
+		return nil
+	}
+	if _, err := cvcs.Update(context.TODO(), newCVC, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to request migration of volume %s replica", pvName)
+	}
+	fmt.Fprintf(c.out, "cstorvolumeconfig/%s migration of replica from pool %s to %s requested\n",
+		pvName, fromPool, toPool)
+	if timeout == 0 {
+		return nil
+	}
+
+	var lastMessage string
+	err = wait.PollImmediate(c.pollInterval, timeout, func() (bool, error) {
+		cvc, err := c.getCVC(pvName)
+		if err != nil {
+			return false, err
+		}
+		for _, cond := range cvc.Status.Conditions {
+			if cond.Type == cvcutil.CStorVolumeConfigMigrateFailed {
+				return false, errors.Errorf("migration of volume %s replica failed: %s", pvName, cond.Message)
+			}
+			if cond.Type == cvcutil.CStorVolumeConfigMigrating && cond.Message != lastMessage {
+				lastMessage = cond.Message
+				fmt.Fprintf(c.out, "  %s\n", cond.Message)
+			}
+		}
+		return cvc.GetAnnotations()[cvcutil.MigrateReplicaAnnotation] == "", nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("migration of volume %s replica didn't finish within %s, it continues in "+
+			"the background, remove annotation %s of the cstorvolumeconfig to cancel it",
+			pvName, timeout, cvcutil.MigrateReplicaAnnotation)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "cstorvolumeconfig/%s replica migrated from pool %s to %s\n", pvName, fromPool, toPool)
+	return nil
+}
+
+// annotationsOf returns the annotations in the form they appear in the
+// metadata of objects
+func annotationsOf(annotations map[string]string) interface{} {
+	return struct {
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}{annotations}
+}
+
+func (c *Client) getCVC(pvName string) (*cstor.CStorVolumeConfig, error) {
+	cvc, err := c.clientset.CstorV1().CStorVolumeConfigs(c.namespace).
+		Get(context.TODO(), pvName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cstorvolumeconfig of volume %s", pvName)
+	}
+	return cvc, nil
+}
+
+// DescribeVolume writes the details of the volume along with its target
+// and replicas
+func (c *Client) DescribeVolume(pvName string) error {
+	cvc, err := c.getCVC(pvName)
+	if err != nil {
+		return err
+	}
+	cvrList, err := c.clientset.CstorV1().CStorVolumeReplicas(c.namespace).
+		List(context.TODO(), metav1.ListOptions{LabelSelector: types.PersistentVolumeLabelKey + "=" + pvName})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list cstorvolumereplicas of volume %s", pvName)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+	capacity := cvc.Spec.Capacity.Storage()
+	fmt.Fprintf(w, "Name:\t%s\n", cvc.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", cvc.Namespace)
+	fmt.Fprintf(w, "Phase:\t%s\n", cvc.Status.Phase)
+	fmt.Fprintf(w, "Capacity:\t%s\n", capacity.String())
+	fmt.Fprintf(w, "Replica pools:\t%s\n", strings.Join(cvc.GetDesiredReplicaPoolNames(), ", "))
+	fmt.Fprintf(w, "Current pools:\t%s\n", strings.Join(cvc.Status.PoolInfo, ", "))
+
+	cv, err := c.clientset.CstorV1().CStorVolumes(c.namespace).
+		Get(context.TODO(), pvName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		fmt.Fprintf(w, "Target phase:\t%s\n", cv.Status.Phase)
+		fmt.Fprintf(w, "Target capacity:\t%s\n", cv.Status.Capacity.String())
+		fmt.Fprintf(w, "Replication factor:\t%d, consistency factor: %d\n",
+			cv.Spec.ReplicationFactor, cv.Spec.ConsistencyFactor)
+	case k8serror.IsNotFound(err):
+		fmt.Fprintf(w, "Target phase:\t<not created>\n")
+	default:
+		return errors.Wrapf(err, "failed to get cstorvolume %s", pvName)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "REPLICA\tPOOL\tNODE\tPHASE\tUSED\tPENDING SNAPSHOTS")
+	for _, cvr := range cvrList.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			cvr.Name, cvr.Labels[types.CStorPoolInstanceNameLabelKey],
+			cvr.Annotations[cspiHostNameAnnotation], cvr.Status.Phase,
+			cvr.Status.Capacity.Used, len(cvr.Status.PendingSnapshots))
+	}
+	if cv != nil && len(cv.Status.ReplicaStatuses) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "REPLICA ID\tMODE\tQUORUM\tCHECKPOINTED IO SEQ")
+		for _, rs := range cv.Status.ReplicaStatuses {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rs.ID, rs.Mode, rs.Quorum, rs.CheckpointedIOSeq)
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventstream publishes the state changes of pools, volume replicas
+// and backups observed via informers to the subscribers of the CVC server
+// event stream, so that consumers react to the changes instead of polling
+// the status of the custom resources.
+package eventstream
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Type is the type of event in the stream
+type Type string
+
+const (
+	// PoolPhaseChanged is published when the phase of a CSPI changes
+	PoolPhaseChanged Type = "pool.phase"
+	// PoolReadOnlyChanged is published when a CSPI turns read only or
+	// writable again
+	PoolReadOnlyChanged Type = "pool.readonly"
+	// ReplicaPhaseChanged is published when the phase of a CVR changes
+	ReplicaPhaseChanged Type = "replica.phase"
+	// ReplicaRebuildProgress is published when a rebuilding CVR receives
+	// pending snapshots from the other replicas
+	ReplicaRebuildProgress Type = "replica.rebuild"
+	// BackupCompleted is published when a CStorBackup is done or failed
+	BackupCompleted Type = "backup.completed"
+	// StreamReset is sent to a subscriber resuming the stream from an event
+	// which is not retained by the broker e.g. an event of another replica of
+	// the server or of the previous run, the subscriber should resync the
+	// state of the objects instead of relying on the events it missed
+	StreamReset Type = "stream.reset"
+)
+
+const (
+	// DefaultHistorySize is the number of recent events retained to
+	// replay to the subscribers resuming the stream
+	DefaultHistorySize = 1024
+
+	// subscriberBufferSize is the number of events buffered per subscriber,
+	// subscribers lagging behind by more are dropped
+	subscriberBufferSize = 256
+)
+
+// Event is a state change of a pool, volume replica or backup
+type Event struct {
+	// Epoch identifies the broker which published the event, IDs are
+	// comparable only within an epoch
+	Epoch string `json:"epoch"`
+	// ID increases monotonically with every event published by the broker
+	ID uint64 `json:"id"`
+	// Type is the type of event
+	Type Type `json:"type"`
+	// Time is the time when the change was observed
+	Time time.Time `json:"time"`
+	// Kind, Name and Namespace identify the object that changed
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Pool is the name of the CSPI the object belongs to if any
+	Pool string `json:"pool,omitempty"`
+	// PoolCluster is the name of the CSPC the object belongs to if any
+	PoolCluster string `json:"poolCluster,omitempty"`
+	// Volume is the name of the volume the object belongs to if any
+	Volume string `json:"volume,omitempty"`
+	// From and To are the previous and current state e.g. phase
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	// Progress is the percentage of rebuild completed by a replica
+	Progress *int `json:"progress,omitempty"`
+	// Message describes the change if any
+	Message string `json:"message,omitempty"`
+}
+
+// EventID returns the id of the event sent to the clients, it is made of
+// the epoch and the ID of the event
+func (e Event) EventID() string {
+	return e.Epoch + "-" + strconv.FormatUint(e.ID, 10)
+}
+
+// ParseEventID returns the epoch and the ID of the given event id
+func ParseEventID(eventID string) (string, uint64, error) {
+	i := strings.LastIndex(eventID, "-")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid event id %q", eventID)
+	}
+	id, err := strconv.ParseUint(eventID[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid event id %q", eventID)
+	}
+	return eventID[:i], id, nil
+}
+
+// NewEpoch returns the epoch of the broker started at the given time by the
+// pod of given UID. Brokers of every replica of the server and of every run
+// of the pod have their own epoch.
+func NewEpoch(podUID string, start time.Time) string {
+	return podUID + "." + strconv.FormatInt(start.UnixNano(), 10)
+}
+
+// Filter selects the events a subscriber is interested in, empty fields
+// match every event
+type Filter struct {
+	// Types are the event types or type prefixes e.g. pool
+	Types []string
+	// Volume is the name of the volume
+	Volume string
+	// Pool is the name of the CSPI or the CSPC
+	Pool string
+}
+
+// Matches returns true if the event passes the filter
+func (f Filter) Matches(e Event) bool {
+	if f.Volume != "" && f.Volume != e.Volume {
+		return false
+	}
+	if f.Pool != "" && f.Pool != e.Pool && f.Pool != e.PoolCluster {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if string(e.Type) == t || strings.HasPrefix(string(e.Type), t+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription receives the events matching its filter
+type Subscription struct {
+	// Events receives the events in order, it is closed when the subscriber
+	// is dropped for lagging behind or the broker is stopped
+	Events <-chan Event
+	events chan Event
+	filter Filter
+}
+
+// Broker fans out the published events to the subscribers and retains the
+// recent events for the subscribers resuming the stream
+type Broker struct {
+	lock        sync.Mutex
+	epoch       string
+	lastID      uint64
+	history     []Event
+	historySize int
+	subscribers map[*Subscription]struct{}
+	now         func() time.Time
+}
+
+// NewBroker returns a broker of the given epoch retaining the given number
+// of recent events
+func NewBroker(epoch string, historySize int) *Broker {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Broker{
+		epoch:       epoch,
+		historySize: historySize,
+		subscribers: map[*Subscription]struct{}{},
+		now:         time.Now,
+	}
+}
+
+// Publish assigns the next ID to the event and delivers it to the matching
+// subscribers. Publish never blocks on subscribers.
+func (b *Broker) Publish(e Event) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.lastID++
+	e.Epoch = b.epoch
+	e.ID = b.lastID
+	if e.Time.IsZero() {
+		e.Time = b.now()
+	}
+	b.history = append(b.history, e)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+	for sub := range b.subscribers {
+		if !sub.filter.Matches(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			// subscriber is lagging behind, dropping it lets the client
+			// resume the stream from the last event it received
+			b.drop(sub)
+		}
+	}
+}
+
+// Subscribe returns a subscription to the events matching the filter. If
+// the client resumes the stream after the event of given epoch and ID, the
+// retained events published after it are replayed first, so that it doesn't
+// miss events. StreamReset is sent instead if the event is of another epoch
+// or is older than the retained events.
+func (b *Broker) Subscribe(filter Filter, epoch string, lastID uint64) *Subscription {
+	events := make(chan Event, subscriberBufferSize+b.historySize)
+	sub := &Subscription{Events: events, events: events, filter: filter}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if epoch != "" || lastID != 0 {
+		if b.canResume(epoch, lastID) {
+			for _, e := range b.history {
+				if e.ID > lastID && filter.Matches(e) {
+					sub.events <- e
+				}
+			}
+		} else {
+			sub.events <- Event{
+				Epoch:   b.epoch,
+				ID:      b.lastID,
+				Type:    StreamReset,
+				Time:    b.now(),
+				Message: "events after the last event id are not available, resync the state",
+			}
+		}
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// canResume returns true if none of the events published after the event of
+// given epoch and ID are missing from the history, the caller must hold the
+// lock
+func (b *Broker) canResume(epoch string, lastID uint64) bool {
+	if epoch != b.epoch || lastID > b.lastID {
+		return false
+	}
+	if len(b.history) == 0 {
+		return true
+	}
+	return lastID+1 >= b.history[0].ID
+}
+
+// Unsubscribe stops delivering events to the subscription
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.drop(sub)
+}
+
+// Stop closes every subscription
+func (b *Broker) Stop() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for sub := range b.subscribers {
+		b.drop(sub)
+	}
+}
+
+// drop closes the subscription, the caller must hold the lock
+func (b *Broker) drop(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
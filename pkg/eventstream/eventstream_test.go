@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventstream
+
+import (
+	"testing"
+	"time"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// receive returns the events buffered on the subscription
+func receive(sub *Subscription) []Event {
+	var events []Event
+	for {
+		select {
+		case e, ok := <-sub.Events:
+			if !ok {
+				return events
+			}
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestBrokerFilterAndResume(t *testing.T) {
+	broker := NewBroker("uid-1.1", 2)
+	pools := broker.Subscribe(Filter{Types: []string{"pool"}}, "", 0)
+	volume := broker.Subscribe(Filter{Volume: "pvc-1"}, "", 0)
+
+	broker.Publish(Event{Type: PoolPhaseChanged, Pool: "cspi-1", PoolCluster: "cspc"})
+	broker.Publish(Event{Type: ReplicaPhaseChanged, Pool: "cspi-1", Volume: "pvc-1"})
+	broker.Publish(Event{Type: PoolReadOnlyChanged, Pool: "cspi-2"})
+
+	if got := receive(pools); len(got) != 2 || got[0].ID != 1 || got[1].ID != 3 {
+		t.Errorf("expected pool events 1 and 3 but got %+v", got)
+	}
+	if got := receive(volume); len(got) != 1 || got[0].ID != 2 {
+		t.Errorf("expected replica event 2 but got %+v", got)
+	}
+
+	// history retains the last two events, resuming after event 1 replays
+	// the events 2 and 3 matching the filter
+	resumed := broker.Subscribe(Filter{Pool: "cspi-1"}, "uid-1.1", 1)
+	if got := receive(resumed); len(got) != 1 || got[0].ID != 2 {
+		t.Errorf("expected replayed event 2 but got %+v", got)
+	}
+	byCluster := broker.Subscribe(Filter{Pool: "cspc"}, "", 0)
+	broker.Publish(Event{Type: PoolPhaseChanged, Pool: "cspi-1", PoolCluster: "cspc"})
+	if got := receive(byCluster); len(got) != 1 || got[0].ID != 4 {
+		t.Errorf("expected pool cluster event 4 but got %+v", got)
+	}
+
+	broker.Unsubscribe(volume)
+	broker.Stop()
+	if got := receive(pools); len(got) != 1 || got[0].ID != 4 {
+		t.Errorf("expected pool event 4 but got %+v", got)
+	}
+	if _, ok := <-pools.Events; ok {
+		t.Errorf("expected subscription to be closed on stop")
+	}
+}
+
+func TestBrokerResetsStream(t *testing.T) {
+	broker := NewBroker("uid-1.1", 2)
+	for i := 0; i < 4; i++ {
+		broker.Publish(Event{Type: PoolPhaseChanged})
+	}
+	tests := map[string]struct {
+		epoch       string
+		lastID      uint64
+		expectReset bool
+		expectIDs   []uint64
+	}{
+		"resumed within history": {
+			epoch:     "uid-1.1",
+			lastID:    2,
+			expectIDs: []uint64{3, 4},
+		},
+		"resumed from latest event": {
+			epoch:  "uid-1.1",
+			lastID: 4,
+		},
+		"events missing from history": {
+			epoch:       "uid-1.1",
+			lastID:      1,
+			expectReset: true,
+		},
+		"event of another replica": {
+			epoch:       "uid-2.1",
+			lastID:      3,
+			expectReset: true,
+		},
+		"event of older release": {
+			lastID:      3,
+			expectReset: true,
+		},
+		"event id ahead of broker": {
+			epoch:       "uid-1.1",
+			lastID:      9,
+			expectReset: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			got := receive(broker.Subscribe(Filter{Types: []string{"replica"}}, test.epoch, test.lastID))
+			if test.expectReset {
+				if len(got) != 1 || got[0].Type != StreamReset || got[0].EventID() != "uid-1.1-4" {
+					t.Errorf("%s test case failed expected reset event but got %+v", name, got)
+				}
+				return
+			}
+			// filter of the subscription doesn't match the replayed events
+			if len(got) != 0 {
+				t.Errorf("%s test case failed expected no events but got %+v", name, got)
+			}
+			got = receive(broker.Subscribe(Filter{}, test.epoch, test.lastID))
+			if len(got) != len(test.expectIDs) {
+				t.Fatalf("%s test case failed expected events %v but got %+v", name, test.expectIDs, got)
+			}
+			for i, id := range test.expectIDs {
+				if got[i].ID != id {
+					t.Errorf("%s test case failed expected events %v but got %+v", name, test.expectIDs, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEventID(t *testing.T) {
+	epoch := NewEpoch("0b1c-4d2e", time.Unix(0, 42))
+	e := Event{Epoch: epoch, ID: 7}
+	gotEpoch, gotID, err := ParseEventID(e.EventID())
+	if err != nil || gotEpoch != epoch || gotID != 7 {
+		t.Errorf("expected %s 7 but got %s %d %v", epoch, gotEpoch, gotID, err)
+	}
+	for _, id := range []string{"", "7", "-7", "epoch-", "epoch-x"} {
+		if _, _, err := ParseEventID(id); err == nil {
+			t.Errorf("expected error for event id %q", id)
+		}
+	}
+}
+
+func TestBrokerDropsLaggingSubscriber(t *testing.T) {
+	broker := NewBroker("uid-1.1", 1)
+	sub := broker.Subscribe(Filter{}, "", 0)
+	for i := 0; i < subscriberBufferSize+2; i++ {
+		broker.Publish(Event{Type: PoolPhaseChanged})
+	}
+	events := receive(sub)
+	if _, ok := <-sub.Events; ok {
+		t.Fatalf("expected lagging subscription to be closed")
+	}
+	if len(events) != subscriberBufferSize+1 {
+		t.Errorf("expected %d buffered events but got %d", subscriberBufferSize+1, len(events))
+	}
+}
+
+func TestPoolEvents(t *testing.T) {
+	old := &cstor.CStorPoolInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cspi-1",
+			Namespace: "openebs",
+			Labels:    map[string]string{types.CStorPoolClusterLabelKey: "cspc"},
+		},
+		Status: cstor.CStorPoolInstanceStatus{Phase: cstor.CStorPoolStatusOnline},
+	}
+	new := old.DeepCopy()
+	if events := poolEvents(old, new); len(events) != 0 {
+		t.Fatalf("expected no events on resync but got %+v", events)
+	}
+
+	new.Status.Phase = cstor.CStorPoolStatusDegraded
+	new.Status.ReadOnly = true
+	events := poolEvents(old, new)
+	if len(events) != 2 {
+		t.Fatalf("expected phase and read only events but got %+v", events)
+	}
+	if e := events[0]; e.Type != PoolPhaseChanged || e.From != "ONLINE" || e.To != "DEGRADED" ||
+		e.Pool != "cspi-1" || e.PoolCluster != "cspc" {
+		t.Errorf("unexpected phase event %+v", e)
+	}
+	if e := events[1]; e.Type != PoolReadOnlyChanged || e.From != "false" || e.To != "true" {
+		t.Errorf("unexpected read only event %+v", e)
+	}
+}
+
+func TestReplicaEvents(t *testing.T) {
+	old := &cstor.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pvc-1-cspi-1",
+			Namespace: "openebs",
+			Labels: map[string]string{
+				types.CStorPoolInstanceNameLabelKey: "cspi-1",
+				types.PersistentVolumeLabelKey:      "pvc-1",
+			},
+		},
+		Status: cstor.CStorVolumeReplicaStatus{Phase: cstor.CVRStatusDegraded},
+	}
+	new := old.DeepCopy()
+	new.Status.Phase = cstor.CVRStatusRebuilding
+	new.Status.Snapshots = map[string]cstor.CStorSnapshotInfo{"s1": {}}
+	new.Status.PendingSnapshots = map[string]cstor.CStorSnapshotInfo{"s2": {}, "s3": {}, "s4": {}}
+
+	events := replicaEvents(old, new)
+	if len(events) != 2 || events[0].Type != ReplicaPhaseChanged || events[1].Type != ReplicaRebuildProgress {
+		t.Fatalf("expected phase and rebuild events but got %+v", events)
+	}
+	if e := events[1]; *e.Progress != 25 || e.Volume != "pvc-1" || e.Pool != "cspi-1" {
+		t.Errorf("unexpected rebuild event %+v", e)
+	}
+
+	old, new = new, new.DeepCopy()
+	delete(new.Status.PendingSnapshots, "s2")
+	delete(new.Status.PendingSnapshots, "s3")
+	delete(new.Status.PendingSnapshots, "s4")
+	new.Status.Snapshots["s2"] = cstor.CStorSnapshotInfo{}
+	events = replicaEvents(old, new)
+	if len(events) != 1 || *events[0].Progress != 99 {
+		t.Fatalf("expected rebuild progress of 99 without pending snapshots but got %+v", events)
+	}
+
+	old, new = new, new.DeepCopy()
+	new.Status.Phase = cstor.CVRStatusOnline
+	events = replicaEvents(old, new)
+	if len(events) != 1 || events[0].To != "Healthy" {
+		t.Errorf("expected only phase event once rebuilt but got %+v", events)
+	}
+}
+
+func TestBackupEvents(t *testing.T) {
+	old := &cstor.CStorBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "snap-pvc-1", Namespace: "openebs"},
+		Spec:       cstor.CStorBackupSpec{BackupName: "backup", VolumeName: "pvc-1", SnapName: "snap"},
+		Status:     cstor.BKPCStorStatusPending,
+	}
+	new := old.DeepCopy()
+	new.Status = cstor.BKPCStorStatusInProgress
+	if events := backupEvents(old, new); len(events) != 0 {
+		t.Fatalf("expected no events for backup in progress but got %+v", events)
+	}
+
+	old, new = new, new.DeepCopy()
+	new.Status = cstor.BKPCStorStatusDone
+	events := backupEvents(old, new)
+	if len(events) != 1 || events[0].Type != BackupCompleted || events[0].To != "Done" || events[0].Volume != "pvc-1" {
+		t.Errorf("expected backup completed event but got %+v", events)
+	}
+	if events := backupEvents(new, new.DeepCopy()); len(events) != 0 {
+		t.Errorf("expected no events on resync but got %+v", events)
+	}
+}
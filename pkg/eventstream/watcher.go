@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventstream
+
+import (
+	"strconv"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	informers "github.com/openebs/api/v3/pkg/client/informers/externalversions"
+	listers "github.com/openebs/api/v3/pkg/client/listers/cstor/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Watcher publishes the state changes of CSPIs, CVRs and CStorBackups
+// observed by the informers to the broker
+type Watcher struct {
+	broker     *Broker
+	cspiLister listers.CStorPoolInstanceLister
+}
+
+// NewWatcher registers the event handlers publishing the state changes to
+// the broker on the informers of the given factory. The factory needs to be
+// started by the caller.
+func NewWatcher(broker *Broker, factory informers.SharedInformerFactory) *Watcher {
+	w := &Watcher{
+		broker:     broker,
+		cspiLister: factory.Cstor().V1().CStorPoolInstances().Lister(),
+	}
+	factory.Cstor().V1().CStorPoolInstances().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{UpdateFunc: w.updateCSPI})
+	factory.Cstor().V1().CStorVolumeReplicas().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{UpdateFunc: w.updateCVR})
+	factory.Cstor().V1().CStorBackups().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{UpdateFunc: w.updateBackup})
+	return w
+}
+
+func (w *Watcher) updateCSPI(oldObj, newObj interface{}) {
+	oldCSPI, ok := oldObj.(*cstor.CStorPoolInstance)
+	if !ok {
+		return
+	}
+	newCSPI, ok := newObj.(*cstor.CStorPoolInstance)
+	if !ok {
+		return
+	}
+	for _, e := range poolEvents(oldCSPI, newCSPI) {
+		w.broker.Publish(e)
+	}
+}
+
+func (w *Watcher) updateCVR(oldObj, newObj interface{}) {
+	oldCVR, ok := oldObj.(*cstor.CStorVolumeReplica)
+	if !ok {
+		return
+	}
+	newCVR, ok := newObj.(*cstor.CStorVolumeReplica)
+	if !ok {
+		return
+	}
+	events := replicaEvents(oldCVR, newCVR)
+	if len(events) == 0 {
+		return
+	}
+	// CVRs don't carry the CSPC label, it is looked up from the CSPI so
+	// that the events can be filtered by pool cluster
+	var cspcName string
+	cspi, err := w.cspiLister.CStorPoolInstances(newCVR.Namespace).
+		Get(newCVR.Labels[types.CStorPoolInstanceNameLabelKey])
+	if err == nil {
+		cspcName = cspi.Labels[types.CStorPoolClusterLabelKey]
+	}
+	for _, e := range events {
+		e.PoolCluster = cspcName
+		w.broker.Publish(e)
+	}
+}
+
+func (w *Watcher) updateBackup(oldObj, newObj interface{}) {
+	oldBackup, ok := oldObj.(*cstor.CStorBackup)
+	if !ok {
+		return
+	}
+	newBackup, ok := newObj.(*cstor.CStorBackup)
+	if !ok {
+		return
+	}
+	for _, e := range backupEvents(oldBackup, newBackup) {
+		w.broker.Publish(e)
+	}
+}
+
+// poolEvents returns the events of the changes from old to new CSPI
+func poolEvents(old, new *cstor.CStorPoolInstance) []Event {
+	var events []Event
+	event := func(t Type, from, to string) Event {
+		return Event{
+			Type:        t,
+			Kind:        "CStorPoolInstance",
+			Name:        new.Name,
+			Namespace:   new.Namespace,
+			Pool:        new.Name,
+			PoolCluster: new.Labels[types.CStorPoolClusterLabelKey],
+			From:        from,
+			To:          to,
+		}
+	}
+	if old.Status.Phase != new.Status.Phase {
+		e := event(PoolPhaseChanged, string(old.Status.Phase), string(new.Status.Phase))
+		e.Message = latestConditionMessage(new)
+		events = append(events, e)
+	}
+	if old.Status.ReadOnly != new.Status.ReadOnly {
+		e := event(PoolReadOnlyChanged,
+			strconv.FormatBool(old.Status.ReadOnly), strconv.FormatBool(new.Status.ReadOnly))
+		if new.Status.ReadOnly {
+			e.Message = "Pool storage limit reached to read only threshold limit"
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// latestConditionMessage returns the message of the most recently
+// transitioned condition of the CSPI
+func latestConditionMessage(cspi *cstor.CStorPoolInstance) string {
+	var message string
+	var latest int64
+	for _, cond := range cspi.Status.Conditions {
+		if t := cond.LastTransitionTime.Unix(); t >= latest {
+			latest = t
+			message = cond.Message
+		}
+	}
+	return message
+}
+
+// replicaEvents returns the events of the changes from old to new CVR
+func replicaEvents(old, new *cstor.CStorVolumeReplica) []Event {
+	var events []Event
+	event := func(t Type, from, to string) Event {
+		return Event{
+			Type:      t,
+			Kind:      "CStorVolumeReplica",
+			Name:      new.Name,
+			Namespace: new.Namespace,
+			Pool:      new.Labels[types.CStorPoolInstanceNameLabelKey],
+			Volume:    new.Labels[types.PersistentVolumeLabelKey],
+			From:      from,
+			To:        to,
+		}
+	}
+	if old.Status.Phase != new.Status.Phase {
+		e := event(ReplicaPhaseChanged, string(old.Status.Phase), string(new.Status.Phase))
+		e.Message = new.Status.Message
+		events = append(events, e)
+	}
+	if isRebuilding(new.Status.Phase) &&
+		(old.Status.Phase != new.Status.Phase ||
+			len(old.Status.PendingSnapshots) != len(new.Status.PendingSnapshots) ||
+			len(old.Status.Snapshots) != len(new.Status.Snapshots)) {
+		e := event(ReplicaRebuildProgress, string(old.Status.Phase), string(new.Status.Phase))
+		progress := rebuildProgress(new)
+		e.Progress = &progress
+		events = append(events, e)
+	}
+	return events
+}
+
+// isRebuilding returns true if the replica is being rebuilt from the other
+// replicas of the volume
+func isRebuilding(phase cstor.CStorVolumeReplicaPhase) bool {
+	return phase == cstor.CVRStatusRebuilding ||
+		phase == cstor.CVRStatusReconstructingNewReplica ||
+		phase == cstor.CVRStatusNewReplicaDegraded
+}
+
+// rebuildProgress returns the percentage of snapshots of the volume which
+// are available on the replica. The data written after the latest snapshot
+// isn't accounted, a replica with every snapshot is 99% rebuilt until it
+// turns healthy.
+func rebuildProgress(cvr *cstor.CStorVolumeReplica) int {
+	available := len(cvr.Status.Snapshots)
+	total := available + len(cvr.Status.PendingSnapshots)
+	if total == 0 {
+		return 0
+	}
+	progress := available * 100 / total
+	if progress == 100 {
+		progress = 99
+	}
+	return progress
+}
+
+// backupEvents returns the events of the changes from old to new backup
+func backupEvents(old, new *cstor.CStorBackup) []Event {
+	if old.Status == new.Status || (!new.IsSucceeded() && !new.IsFailed()) {
+		return nil
+	}
+	return []Event{{
+		Type:      BackupCompleted,
+		Kind:      "CStorBackup",
+		Name:      new.Name,
+		Namespace: new.Namespace,
+		Pool:      new.Labels[types.CStorPoolInstanceNameLabelKey],
+		Volume:    new.Spec.VolumeName,
+		From:      string(old.Status),
+		To:        string(new.Status),
+		Message:   "backup " + new.Spec.BackupName + " of snapshot " + new.Spec.SnapName,
+	}}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health serves the liveness and readiness endpoints of the pool and
+// volume managers. Liveness reflects only the manager itself i.e. controller
+// workers stuck on a work item, so that kubelet restarts a wedged manager
+// container without touching the pool or target container. Readiness
+// additionally reflects informer sync and the data plane the manager talks to.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultPort is the port on which the health endpoints are served
+	DefaultPort = 9501
+	// LivenessPath is the path of liveness endpoint
+	LivenessPath = "/healthz"
+	// ReadinessPath is the path of readiness endpoint
+	ReadinessPath = "/readyz"
+)
+
+// Check returns an error if the checked component isn't healthy
+type Check func() error
+
+// controllerState is the health of a controller
+type controllerState struct {
+	// synced is true once the informer caches of controller are synced
+	synced bool
+	// inProgress holds the time at which the work items currently being
+	// processed by the controller workers were picked
+	inProgress map[uint64]time.Time
+	// longOperations is the number of operations in progress which may run
+	// longer than WorkerTimeout e.g. zfs send or receive of a volume
+	longOperations int
+}
+
+var (
+	lock            sync.Mutex
+	controllers     = map[string]*controllerState{}
+	readinessChecks = map[string]Check{}
+	workID          uint64
+
+	// WorkerTimeout is the time after which a worker processing the same
+	// work item is considered stuck
+	WorkerTimeout = 30 * time.Minute
+	// now is used to compute the time spent on work items, overridden in
+	// tests
+	now = time.Now
+)
+
+// RegisterController registers the controller whose informer sync and
+// workers are reflected by the health endpoints. Controllers must be
+// registered before they start so that readiness waits for them.
+func RegisterController(name string) {
+	lock.Lock()
+	defer lock.Unlock()
+	if _, ok := controllers[name]; !ok {
+		controllers[name] = &controllerState{inProgress: map[uint64]time.Time{}}
+	}
+}
+
+// MarkSynced marks the informer caches of controller as synced
+func MarkSynced(name string) {
+	lock.Lock()
+	defer lock.Unlock()
+	getController(name).synced = true
+}
+
+// TrackWork marks the work item picked by a worker of the controller as in
+// progress, the returned func must be called once the item is processed
+func TrackWork(name string) func() {
+	lock.Lock()
+	defer lock.Unlock()
+	workID++
+	id := workID
+	getController(name).inProgress[id] = now()
+	return func() {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(getController(name).inProgress, id)
+	}
+}
+
+// TrackLongOperation marks an operation of a controller worker which may
+// legitimately run longer than WorkerTimeout e.g. streaming a volume, so
+// that liveness doesn't restart the manager midway. Each long operation in
+// progress exempts one work item of the controller from the timeout, the
+// returned func must be called once the operation is over.
+func TrackLongOperation(name string) func() {
+	lock.Lock()
+	defer lock.Unlock()
+	getController(name).longOperations++
+	return func() {
+		lock.Lock()
+		defer lock.Unlock()
+		getController(name).longOperations--
+	}
+}
+
+// AddReadinessCheck adds the check which must pass for the manager to be
+// ready e.g. reachability of the data plane
+func AddReadinessCheck(name string, check Check) {
+	lock.Lock()
+	defer lock.Unlock()
+	readinessChecks[name] = check
+}
+
+// getController returns the state of controller registering it if required,
+// must be called with lock held
+func getController(name string) *controllerState {
+	state, ok := controllers[name]
+	if !ok {
+		state = &controllerState{inProgress: map[uint64]time.Time{}}
+		controllers[name] = state
+	}
+	return state
+}
+
+// checkLiveness returns the result of liveness checks per check name
+func checkLiveness() map[string]error {
+	lock.Lock()
+	defer lock.Unlock()
+	results := map[string]error{}
+	for name, state := range controllers {
+		var err error
+		var stuck int
+		var longest time.Duration
+		for _, startTime := range state.inProgress {
+			if elapsed := now().Sub(startTime); elapsed > WorkerTimeout {
+				stuck++
+				if elapsed > longest {
+					longest = elapsed
+				}
+			}
+		}
+		// workers running long operations are expected to exceed the
+		// timeout, only the ones beyond them are stuck
+		if stuck > state.longOperations {
+			err = fmt.Errorf("worker stuck on a work item for %s", longest.Round(time.Second))
+		}
+		results[name+"-workers"] = err
+	}
+	return results
+}
+
+// checkReadiness returns the result of readiness checks per check name,
+// liveness checks are included as a wedged manager isn't ready either
+func checkReadiness() map[string]error {
+	results := checkLiveness()
+	lock.Lock()
+	checks := map[string]Check{}
+	for name, state := range controllers {
+		var err error
+		if !state.synced {
+			err = fmt.Errorf("informer caches not synced")
+		}
+		results[name+"-informers"] = err
+	}
+	for name, check := range readinessChecks {
+		checks[name] = check
+	}
+	lock.Unlock()
+
+	// checks may talk to the data plane, hence run without lock
+	for name, check := range checks {
+		results[name] = check()
+	}
+	return results
+}
+
+// handler writes the results of checks in the same format as the kubernetes
+// components, failing the request if any of the checks failed
+func handler(checks func() map[string]error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		results := checks()
+		names := make([]string, 0, len(results))
+		for name := range results {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var b strings.Builder
+		failed := false
+		for _, name := range names {
+			if err := results[name]; err != nil {
+				failed = true
+				fmt.Fprintf(&b, "[-]%s failed: %v\n", name, err)
+				continue
+			}
+			fmt.Fprintf(&b, "[+]%s ok\n", name)
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if failed {
+			klog.Warningf("%s check failed:\n%s", r.URL.Path, b.String())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			b.WriteString("check failed\n")
+		} else {
+			w.WriteHeader(http.StatusOK)
+			b.WriteString("ok\n")
+		}
+		_, _ = w.Write([]byte(b.String()))
+	}
+}
+
+// Handler returns the handler serving the liveness and readiness endpoints
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(LivenessPath, handler(checkLiveness))
+	mux.HandleFunc(ReadinessPath, handler(checkReadiness))
+	return mux
+}
+
+// StartServer serves the health endpoints on the given port until stopCh is
+// closed
+func StartServer(port int, stopCh <-chan struct{}) {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-stopCh
+		_ = server.Shutdown(context.Background())
+	}()
+	go func() {
+		klog.Infof("Serving health endpoints on port %d", port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			klog.Errorf("Failed to serve health endpoints: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// reset clears the registered controllers and checks
+func reset() {
+	lock.Lock()
+	defer lock.Unlock()
+	controllers = map[string]*controllerState{}
+	readinessChecks = map[string]Check{}
+	now = time.Now
+}
+
+func probe(t *testing.T, path string) (int, string) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Code, rec.Body.String()
+}
+
+func TestLiveness(t *testing.T) {
+	defer reset()
+	reset()
+	RegisterController("cspi")
+
+	if code, body := probe(t, LivenessPath); code != http.StatusOK {
+		t.Fatalf("expected live manager but got %d: %s", code, body)
+	}
+
+	done := TrackWork("cspi")
+	now = func() time.Time { return time.Now().Add(WorkerTimeout + time.Minute) }
+	code, body := probe(t, LivenessPath)
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, "[-]cspi-workers failed") {
+		t.Fatalf("expected stuck worker to fail liveness but got %d: %s", code, body)
+	}
+
+	done()
+	if code, body := probe(t, LivenessPath); code != http.StatusOK {
+		t.Fatalf("expected live manager once work item is processed but got %d: %s", code, body)
+	}
+}
+
+func TestLivenessLongOperation(t *testing.T) {
+	defer reset()
+	reset()
+	RegisterController("cvr")
+
+	done := TrackWork("cvr")
+	defer done()
+	doneOp := TrackLongOperation("cvr")
+	now = func() time.Time { return time.Now().Add(WorkerTimeout + time.Minute) }
+	if code, body := probe(t, LivenessPath); code != http.StatusOK {
+		t.Fatalf("expected worker running long operation to be live but got %d: %s", code, body)
+	}
+
+	// another worker exceeding the timeout isn't covered by the operation
+	now = time.Now
+	doneOther := TrackWork("cvr")
+	defer doneOther()
+	now = func() time.Time { return time.Now().Add(WorkerTimeout + time.Minute) }
+	code, body := probe(t, LivenessPath)
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, "[-]cvr-workers failed") {
+		t.Fatalf("expected stuck worker to fail liveness but got %d: %s", code, body)
+	}
+
+	doneOther()
+	doneOp()
+	code, body = probe(t, LivenessPath)
+	if code != http.StatusServiceUnavailable {
+		t.Fatalf("expected worker past timeout after long operation to fail liveness but got %d: %s", code, body)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	defer reset()
+	reset()
+	RegisterController("cspi")
+	RegisterController("cvr")
+	var zreplErr error
+	AddReadinessCheck("zrepl", func() error { return zreplErr })
+
+	MarkSynced("cspi")
+	code, body := probe(t, ReadinessPath)
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, "[-]cvr-informers failed") {
+		t.Fatalf("expected unsynced controller to fail readiness but got %d: %s", code, body)
+	}
+
+	MarkSynced("cvr")
+	if code, body := probe(t, ReadinessPath); code != http.StatusOK {
+		t.Fatalf("expected ready manager but got %d: %s", code, body)
+	}
+
+	zreplErr = fmt.Errorf("zrepl not reachable")
+	code, body = probe(t, ReadinessPath)
+	if code != http.StatusServiceUnavailable || !strings.Contains(body, "[-]zrepl failed: zrepl not reachable") {
+		t.Fatalf("expected failing check to fail readiness but got %d: %s", code, body)
+	}
+	if code, body := probe(t, LivenessPath); code != http.StatusOK {
+		t.Fatalf("expected readiness checks not to fail liveness but got %d: %s", code, body)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alertlog
+
+import "sort"
+
+// EventCode identifies the alert in the alerting pipeline. Event codes are
+// part of the alert schema, released codes must not be renamed or removed.
+type EventCode string
+
+// Severity is the severity of the alert
+type Severity string
+
+const (
+	// SeverityInfo is the severity of alerts about successful operations
+	SeverityInfo Severity = "info"
+	// SeverityWarning is the severity of alerts which need attention but
+	// don't impact the volumes e.g. denied admission requests
+	SeverityWarning Severity = "warning"
+	// SeverityError is the severity of alerts about failed operations
+	SeverityError Severity = "error"
+)
+
+// Event codes of the volume target
+const (
+	VolumeTargetCreateSuccess   EventCode = "cstor.volume.target.create.success"
+	VolumeTargetCreateFailure   EventCode = "cstor.volume.target.create.failure"
+	VolumeTargetResizeSuccess   EventCode = "cstor.volume.target.resize.success"
+	VolumeTargetResizeFailure   EventCode = "cstor.volume.target.resize.failure"
+	VolumeTargetFailoverSuccess EventCode = "cstor.volume.target.failover.success"
+	VolumeTargetFenced          EventCode = "cstor.volume.target.fenced"
+	VolumeTargetFenceFailure    EventCode = "cstor.volume.target.fence.failure"
+)
+
+// Event codes of the volume replicas
+const (
+	VolumeReplicaCreateSuccess      EventCode = "cstor.volume.replica.create.success"
+	VolumeReplicaCreateFailure      EventCode = "cstor.volume.replica.create.failure"
+	VolumeReplicaCloneCreateSuccess EventCode = "cstor.volume.replica.clone.create.success"
+	VolumeReplicaCloneCreateFailure EventCode = "cstor.volume.replica.clone.create.failure"
+	VolumeReplicaPromoteSuccess     EventCode = "cstor.volume.replica.promote.success"
+	VolumeReplicaPromoteFailure     EventCode = "cstor.volume.replica.promote.failure"
+	VolumeReplicaSeedSendFailure    EventCode = "cstor.volume.replica.seed.send.failure"
+	VolumeReplicaSeedReceiveFailure EventCode = "cstor.volume.replica.seed.receive.failure"
+	VolumeDeleteSuccess             EventCode = "cstor.volume.delete.success"
+	VolumeDeleteFailure             EventCode = "cstor.volume.delete.failure"
+)
+
+// Event codes of the backup and restore of volumes
+const (
+	VolumeBackupCreateSuccess EventCode = "cstor.volume.backup.create.success"
+	VolumeBackupCreateFailure EventCode = "cstor.volume.backup.create.failure"
+	VolumeRestoreSuccess      EventCode = "cstor.volume.restore.success"
+	VolumeRestoreFailure      EventCode = "cstor.volume.restore.failure"
+)
+
+// Event codes of the admission webhook
+const (
+	AdmissionRequestAllowed EventCode = "cstor.admission.request.allowed"
+	AdmissionRequestDenied  EventCode = "cstor.admission.request.denied"
+)
+
+// EventSpec describes an event code of the catalogue
+type EventSpec struct {
+	// Code is the event code of the alert
+	Code EventCode `json:"code"`
+	// Severity is the severity of the alert
+	Severity Severity `json:"severity"`
+	// Reason is the reason of the Kubernetes Event the alert is forwarded as
+	Reason string `json:"reason"`
+	// Description describes when the alert is raised
+	Description string `json:"description"`
+}
+
+// catalogue holds every event code raised by the cStor components
+var catalogue = map[EventCode]EventSpec{}
+
+func init() {
+	for _, spec := range []EventSpec{
+		{VolumeTargetCreateSuccess, SeverityInfo, "TargetCreated",
+			"Volume target is configured with the cstorvolume"},
+		{VolumeTargetCreateFailure, SeverityError, "TargetCreateFailed",
+			"Volume target configuration couldn't be built from the cstorvolume"},
+		{VolumeTargetResizeSuccess, SeverityInfo, "TargetResized",
+			"Volume target is resized to the capacity of the cstorvolume"},
+		{VolumeTargetResizeFailure, SeverityError, "TargetResizeFailed",
+			"Volume target couldn't be resized to the capacity of the cstorvolume"},
+		{VolumeTargetFailoverSuccess, SeverityInfo, "TargetFailedOver",
+			"Standby target pod took over as the active target of the volume"},
+		{VolumeTargetFenced, SeverityWarning, "TargetFenced",
+			"Target pod lost the Lease and killed istgt to drop the sessions of the volume"},
+		{VolumeTargetFenceFailure, SeverityError, "TargetFenceFailed",
+			"Target pod lost the Lease but couldn't kill istgt, the volume may be served by two target pods"},
+		{VolumeReplicaCreateSuccess, SeverityInfo, "ReplicaCreated",
+			"Volume replica is created on the pool"},
+		{VolumeReplicaCreateFailure, SeverityError, "ReplicaCreateFailed",
+			"Volume replica couldn't be created on the pool"},
+		{VolumeReplicaCloneCreateSuccess, SeverityInfo, "ReplicaCloneCreated",
+			"Volume replica of the clone volume is created on the pool"},
+		{VolumeReplicaCloneCreateFailure, SeverityError, "ReplicaCloneCreateFailed",
+			"Volume replica of the clone volume couldn't be created on the pool"},
+		{VolumeReplicaPromoteSuccess, SeverityInfo, "ReplicaPromoted",
+			"Volume replica of the clone volume no longer depends on the source snapshot"},
+		{VolumeReplicaPromoteFailure, SeverityError, "ReplicaPromoteFailed",
+			"Volume replica of the clone volume couldn't be promoted"},
+		{VolumeReplicaSeedSendFailure, SeverityError, "ReplicaSeedSendFailed",
+			"Source snapshot couldn't be sent to the replica of the clone volume on another pool"},
+		{VolumeReplicaSeedReceiveFailure, SeverityError, "ReplicaSeedReceiveFailed",
+			"Replica of the clone volume couldn't receive the source snapshot from another pool"},
+		{VolumeDeleteSuccess, SeverityInfo, "ReplicaDeleted",
+			"Volume replica dataset is deleted from the pool"},
+		{VolumeDeleteFailure, SeverityError, "ReplicaDeleteFailed",
+			"Volume replica dataset couldn't be deleted from the pool"},
+		{VolumeBackupCreateSuccess, SeverityInfo, "BackupCreated",
+			"Snapshot of the volume replica is sent to the backup destination"},
+		{VolumeBackupCreateFailure, SeverityError, "BackupCreateFailed",
+			"Snapshot of the volume replica couldn't be sent to the backup destination"},
+		{VolumeRestoreSuccess, SeverityInfo, "RestoreCompleted",
+			"Volume replica is restored from the backup"},
+		{VolumeRestoreFailure, SeverityError, "RestoreFailed",
+			"Volume replica couldn't be restored from the backup"},
+		{AdmissionRequestAllowed, SeverityInfo, "AdmissionAllowed",
+			"Admission request is allowed by the admission webhook"},
+		{AdmissionRequestDenied, SeverityWarning, "AdmissionDenied",
+			"Admission request is denied by the admission webhook"},
+	} {
+		catalogue[spec.Code] = spec
+	}
+}
+
+// Lookup returns the spec of the event code
+func Lookup(code EventCode) (EventSpec, bool) {
+	spec, ok := catalogue[code]
+	return spec, ok
+}
+
+// Catalogue returns the spec of every event code ordered by code
+func Catalogue() []EventSpec {
+	specs := make([]EventSpec, 0, len(catalogue))
+	for _, spec := range catalogue {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Code < specs[j].Code })
+	return specs
+}
@@ -18,29 +18,168 @@ package alertlog
 
 import (
 	"log"
+	"os"
+	"sync"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// EventsEnvVar enables forwarding the alerts as Kubernetes Events on the
+	// resource of the alert if set to true
+	EventsEnvVar = "OPENEBS_IO_ALERT_EVENTS"
+
+	// EventCodeAnnotation holds the event code on the forwarded Events
+	EventCodeAnnotation = "cstor.openebs.io/eventcode"
+	// SeverityAnnotation holds the severity on the forwarded Events
+	SeverityAnnotation = "cstor.openebs.io/severity"
 )
 
 var (
 	// Logger facilitates logging with alert format
 	Logger = initLogger()
+
+	// recorder forwards the alerts as Kubernetes Events if set
+	recorder     record.EventRecorder
+	recorderLock sync.RWMutex
 )
 
+// initLogger returns the logger writing alerts as JSON lines to stderr.
+// Alerts are never sampled unlike the default production logger.
 func initLogger() *zap.SugaredLogger {
-	logger, err := zap.NewDevelopment()
+	config := zap.NewProductionConfig()
+	config.Sampling = nil
+	config.EncoderConfig.TimeKey = "time"
+	config.EncoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	logger, err := config.Build()
 	if err != nil {
 		log.Fatalf("can't initialize zap logger: %v", err)
 	}
-	//logger, err := zap.NewProduction()
-	defer logger.Sync() // flushes buffer, if any
 	return logger.Sugar()
 }
 
-//sugar.Infow("failed to fetch URL",
-//// Structured context as loosely typed key-value pairs.
-//"url", url,
-//"attempt", 3,
-//"backoff", time.Second,
-//)
-//sugar.Infof("Failed to fetch URL: %s", url)
+// Resource is the Kubernetes resource, or the pool dataset, the alert is
+// about
+type Resource struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+	UID        types.UID
+}
+
+// NewResource returns the Resource of the given object
+func NewResource(gvk schema.GroupVersionKind, obj metav1.Object) Resource {
+	return Resource{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+		UID:        obj.GetUID(),
+	}
+}
+
+// Dataset returns the Resource of the given volume dataset of pool
+func Dataset(name string) Resource {
+	return Resource{Kind: "Dataset", Name: name}
+}
+
+// Alert logs the alert of the given event code with the fields of alert
+// schema i.e eventcode, severity and resource followed by the given key
+// value pairs, and forwards it as a Kubernetes Event if enabled.
+func Alert(code EventCode, msg string, resource Resource, keysAndValues ...interface{}) {
+	spec, ok := Lookup(code)
+	if !ok {
+		spec = EventSpec{Code: code, Severity: SeverityError, Reason: "Unknown"}
+	}
+	fields := append([]interface{}{
+		"eventcode", string(code),
+		"severity", string(spec.Severity),
+		"resource.apiVersion", resource.APIVersion,
+		"resource.kind", resource.Kind,
+		"resource.name", resource.Name,
+		"resource.namespace", resource.Namespace,
+		"resource.uid", string(resource.UID),
+	}, keysAndValues...)
+
+	switch spec.Severity {
+	case SeverityInfo:
+		Logger.Infow(msg, fields...)
+	case SeverityWarning:
+		Logger.Warnw(msg, fields...)
+	default:
+		Logger.Errorw(msg, fields...)
+	}
+	forward(spec, msg, resource)
+}
+
+// forward records the alert as a Kubernetes Event on the resource, alerts on
+// resources other than Kubernetes objects e.g. datasets aren't forwarded
+func forward(spec EventSpec, msg string, resource Resource) {
+	recorderLock.RLock()
+	r := recorder
+	recorderLock.RUnlock()
+	if r == nil || resource.APIVersion == "" || resource.Name == "" {
+		return
+	}
+	eventType := corev1.EventTypeWarning
+	if spec.Severity == SeverityInfo {
+		eventType = corev1.EventTypeNormal
+	}
+	ref := &corev1.ObjectReference{
+		APIVersion: resource.APIVersion,
+		Kind:       resource.Kind,
+		Name:       resource.Name,
+		Namespace:  resource.Namespace,
+		UID:        resource.UID,
+	}
+	r.AnnotatedEventf(ref,
+		map[string]string{
+			EventCodeAnnotation: string(spec.Code),
+			SeverityAnnotation:  string(spec.Severity),
+		},
+		eventType, spec.Reason, "%s", msg)
+}
+
+// SetEventRecorder sets the recorder to which the alerts are forwarded as
+// Kubernetes Events, nil stops forwarding the alerts
+func SetEventRecorder(r record.EventRecorder) {
+	recorderLock.Lock()
+	defer recorderLock.Unlock()
+	recorder = r
+}
+
+// EventsEnabled returns true if the alerts are to be forwarded as Kubernetes
+// Events
+func EventsEnabled() bool {
+	return os.Getenv(EventsEnvVar) == "true"
+}
+
+// ForwardToEvents forwards the alerts raised by the given component as
+// Kubernetes Events if enabled via EventsEnvVar
+func ForwardToEvents(kubeClient kubernetes.Interface, component string) {
+	if !EventsEnabled() {
+		return
+	}
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	SetEventRecorder(broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component}))
+}
+
+// Env returns the alert configuration of this component to be passed on to
+// the containers it deploys
+func Env() []corev1.EnvVar {
+	if value, ok := os.LookupEnv(EventsEnvVar); ok {
+		return []corev1.EnvVar{{Name: EventsEnvVar, Value: value}}
+	}
+	return nil
+}
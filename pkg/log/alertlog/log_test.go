@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alertlog
+
+import (
+	"strings"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// observe redirects the alerts to the returned observer for the test
+func observe(t *testing.T) *observer.ObservedLogs {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := Logger
+	Logger = zap.New(core).Sugar()
+	t.Cleanup(func() { Logger = logger })
+	return logs
+}
+
+func TestCatalogue(t *testing.T) {
+	reasons := map[string]EventCode{}
+	for _, spec := range Catalogue() {
+		switch spec.Severity {
+		case SeverityInfo, SeverityWarning, SeverityError:
+		default:
+			t.Errorf("%s: unknown severity %q", spec.Code, spec.Severity)
+		}
+		if !strings.HasPrefix(string(spec.Code), "cstor.") {
+			t.Errorf("%s: expected event code in cstor namespace", spec.Code)
+		}
+		if spec.Reason == "" || spec.Description == "" {
+			t.Errorf("%s: expected reason and description", spec.Code)
+		}
+		if code, ok := reasons[spec.Reason]; ok {
+			t.Errorf("%s: reason %s already used by %s", spec.Code, spec.Reason, code)
+		}
+		reasons[spec.Reason] = spec.Code
+	}
+}
+
+func TestAlert(t *testing.T) {
+	logs := observe(t)
+	cvr := &cstor.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1-cspi-1", Namespace: "openebs", UID: "uid-1"},
+	}
+	resource := NewResource(cstor.SchemeGroupVersion.WithKind("CStorVolumeReplica"), cvr)
+
+	Alert(VolumeReplicaCreateFailure, "Failed to create volume replica", resource, "rname", "pool/pvc-1")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 alert but got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.Level != zapcore.ErrorLevel || entry.Message != "Failed to create volume replica" {
+		t.Errorf("expected error alert with message but got %v %q", entry.Level, entry.Message)
+	}
+	fields := entry.ContextMap()
+	for key, expected := range map[string]string{
+		"eventcode":           string(VolumeReplicaCreateFailure),
+		"severity":            string(SeverityError),
+		"resource.apiVersion": "cstor.openebs.io/v1",
+		"resource.kind":       "CStorVolumeReplica",
+		"resource.name":       "pvc-1-cspi-1",
+		"resource.namespace":  "openebs",
+		"resource.uid":        "uid-1",
+		"rname":               "pool/pvc-1",
+	} {
+		if fields[key] != expected {
+			t.Errorf("expected %s=%q but got %v", key, expected, fields[key])
+		}
+	}
+}
+
+func TestForward(t *testing.T) {
+	observe(t)
+	recorder := record.NewFakeRecorder(4)
+	SetEventRecorder(recorder)
+	defer SetEventRecorder(nil)
+	cvr := &cstor.CStorVolumeReplica{ObjectMeta: metav1.ObjectMeta{Name: "pvc-1-cspi-1", Namespace: "openebs"}}
+
+	Alert(VolumeReplicaCreateSuccess, "Created volume replica",
+		NewResource(cstor.SchemeGroupVersion.WithKind("CStorVolumeReplica"), cvr))
+	Alert(VolumeDeleteFailure, "Failed to delete volume replica", Dataset("pool/pvc-1"))
+	Alert(AdmissionRequestDenied, "Denied admission request",
+		NewResource(cstor.SchemeGroupVersion.WithKind("CStorPoolCluster"),
+			&metav1.ObjectMeta{Name: "cspc", Namespace: "openebs"}))
+
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	expected := []string{
+		"Normal ReplicaCreated Created volume replica " +
+			"map[cstor.openebs.io/eventcode:cstor.volume.replica.create.success cstor.openebs.io/severity:info]",
+		"Warning AdmissionDenied Denied admission request " +
+			"map[cstor.openebs.io/eventcode:cstor.admission.request.denied cstor.openebs.io/severity:warning]",
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected events %v but got %v", expected, events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("expected event %q but got %q", expected[i], events[i])
+		}
+	}
+}
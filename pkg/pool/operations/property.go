@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/cstor-operators/pkg/util/defaults"
 	zcmd "github.com/openebs/cstor-operators/pkg/zcmd"
 	"github.com/pkg/errors"
 )
@@ -112,8 +113,7 @@ func (oc *OperationsConfig) SetPoolProperties(cspi *cstor.CStorPoolInstance) err
 	fsProperties := map[string]string{
 		"canmount": "off"}
 
-	// default compression type is lz4
-	compressionType := "lz4"
+	compressionType := defaults.Compression
 	if cspi.Spec.PoolConfig.Compression != "" {
 		compressionType = cspi.Spec.PoolConfig.Compression
 	}
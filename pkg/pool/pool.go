@@ -104,9 +104,8 @@ func GetPoolName() ([]string, error) {
 
 // CheckForZreplInitial is blocking call for checking status of zrepl in cstor-pool container.
 func CheckForZreplInitial(ZreplRetryInterval time.Duration) {
-	zStatusCmd := zfs.NewPoolStatus()
 	for {
-		_, err := zStatusCmd.Execute()
+		err := CheckZrepl()
 		if err != nil {
 			time.Sleep(ZreplRetryInterval)
 			klog.Errorf("zpool status returned error in zrepl startup : %v", err)
@@ -117,3 +116,10 @@ func CheckForZreplInitial(ZreplRetryInterval time.Duration) {
 		break
 	}
 }
+
+// CheckZrepl returns an error if zrepl running in the pool container isn't
+// reachable
+func CheckZrepl() error {
+	_, err := zfs.NewPoolStatus().Execute()
+	return err
+}
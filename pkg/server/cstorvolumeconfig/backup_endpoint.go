@@ -31,7 +31,9 @@ import (
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	"github.com/openebs/api/v3/pkg/util"
 	snapshot "github.com/openebs/cstor-operators/pkg/snapshot"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -106,14 +108,23 @@ func (s *HTTPServer) backupV1alpha1SpecificRequest(resp http.ResponseWriter, req
 }
 
 // Create is http handler which handles backup create request
-func (bOps *backupAPIOps) create() (interface{}, error) {
+func (bOps *backupAPIOps) create() (_ interface{}, err error) {
 	backup := &cstorapis.CStorBackup{}
 
-	err := decodeBody(bOps.req, backup)
+	err = decodeBody(bOps.req, backup)
 	if err != nil {
 		return nil, err
 	}
 
+	// backup CR carries the trace context to the pool manager so that the
+	// trace of backup request includes the backup of replica
+	ctx, span := tracing.Start(tracing.ExtractHTTP(bOps.req.Context(), bOps.req.Header),
+		backup, "BackupVolume",
+		attribute.String("volume", backup.Spec.VolumeName),
+		attribute.String("snapshot", backup.Spec.SnapName),
+		attribute.Bool("localSnap", backup.Spec.LocalSnap))
+	defer func() { tracing.End(span, err) }()
+
 	if err := backupCreateRequestValidations(backup); err != nil {
 		return nil, err
 	}
@@ -153,6 +164,7 @@ func (bOps *backupAPIOps) create() (interface{}, error) {
 		cstortypes.PersistentVolumeLabelKey:      cvr.ObjectMeta.Labels[cstortypes.PersistentVolumeLabelKey],
 		"openebs.io/backup":                      backup.Spec.BackupName,
 	}
+	tracing.Inject(ctx, backup)
 
 	poolVersion, err := getPoolVersion(poolName, bOps.namespace, bOps.clientset)
 	if err != nil {
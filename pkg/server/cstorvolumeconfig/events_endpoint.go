@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openebs/cstor-operators/pkg/eventstream"
+	"k8s.io/klog/v2"
+)
+
+/***************************REST ENDPOINTS**********************************************************************************************
+ * curl on CVC service with port 5757 to stream the pool, replica and backup events as Server-Sent Events
+ * GET method curl -N http://10.101.149.30:5757/latest/events?type=pool,replica.rebuild\&volume=pvc-185eb80c-f23e-42ea-8136-8863c1c9eb0e
+ *
+ * Query params type, volume and pool are optional and filter the events. Clients resume the stream by
+ * sending the id of the last received event in Last-Event-ID header, a stream.reset event is sent if the
+ * events after it are not available e.g. it was received from another replica of the CVC operator.
+ **************************************************************************************************************************************
+ */
+
+// heartbeatInterval is the interval of comments sent on an idle stream so that
+// proxies don't close the connection
+var heartbeatInterval = 15 * time.Second
+
+// eventsRequest streams the events published by the event broker
+func (s *HTTPServer) eventsRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(resp, ErrGetMethodRequired, http.StatusMethodNotAllowed)
+		return
+	}
+	broker := s.cvcServer.eventBroker
+	if broker == nil {
+		http.Error(resp, "event stream is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	flusher, ok := resp.(http.Flusher)
+	if !ok {
+		http.Error(resp, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	filter, epoch, lastID, err := parseEventsRequest(req)
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sub := broker.Subscribe(filter, epoch, lastID)
+	defer broker.Unsubscribe(sub)
+	klog.V(4).Infof("Streaming events to %s filter: %+v lastEventID: %s-%d", req.RemoteAddr, filter, epoch, lastID)
+
+	setHeaders(resp, s.cvcServer.config.HTTPAPIResponseHeaders)
+	resp.Header().Set("Content-Type", "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-s.cvcServer.shutdownCh:
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(resp, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case e, ok := <-sub.Events:
+			if !ok {
+				// subscription is dropped for lagging behind, the client
+				// resumes the stream from the last event it received
+				return
+			}
+			if err := writeEvent(resp, e); err != nil {
+				klog.V(4).Infof("Failed to stream event %s to %s: %v", e.EventID(), req.RemoteAddr, err)
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// parseEventsRequest returns the filter and the epoch and ID of last event
+// received by the client from the query params and headers of the request
+func parseEventsRequest(req *http.Request) (eventstream.Filter, string, uint64, error) {
+	query := req.URL.Query()
+	filter := eventstream.Filter{
+		Volume: strings.TrimSpace(query.Get("volume")),
+		Pool:   strings.TrimSpace(query.Get("pool")),
+	}
+	for _, t := range strings.Split(query.Get("type"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			filter.Types = append(filter.Types, t)
+		}
+	}
+
+	lastEventID := req.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = query.Get("lastEventID")
+	}
+	if lastEventID == "" {
+		return filter, "", 0, nil
+	}
+	// ids of older releases are plain numbers, the client is asked to
+	// resync as they can't be resumed from
+	if lastID, err := strconv.ParseUint(lastEventID, 10, 64); err == nil {
+		return filter, "", lastID, nil
+	}
+	epoch, lastID, err := eventstream.ParseEventID(lastEventID)
+	if err != nil {
+		return filter, "", 0, err
+	}
+	return filter, epoch, lastID, nil
+}
+
+// writeEvent writes the event in Server-Sent Events format
+func writeEvent(resp http.ResponseWriter, e eventstream.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(resp, "id: %s\nevent: %s\ndata: %s\n\n", e.EventID(), e.Type, data)
+	return err
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cstorvolumeconfig
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/openebs/cstor-operators/pkg/eventstream"
+	server "github.com/openebs/cstor-operators/pkg/server"
+)
+
+func TestEventsEndPoint(t *testing.T) {
+	broker := eventstream.NewBroker("uid-1.1", eventstream.DefaultHistorySize)
+	cvcServer := NewCVCServer(server.DefaultServerConfig(), os.Stdout).WithEventBroker(broker)
+	s := &HTTPServer{cvcServer: cvcServer, mux: http.NewServeMux()}
+	s.registerHandlers()
+	ts := httptest.NewServer(s.mux)
+	defer ts.Close()
+	defer cvcServer.Shutdown()
+
+	// event published before the client connects is replayed since the
+	// client resumes after event 1
+	broker.Publish(eventstream.Event{Type: eventstream.PoolPhaseChanged, Pool: "cspi-1"})
+	broker.Publish(eventstream.Event{Type: eventstream.ReplicaRebuildProgress, Pool: "cspi-1", Volume: "pvc-1"})
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/latest/events?type=replica,backup&volume=pvc-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Last-Event-ID", "uid-1.1-1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("expected event stream but got %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	broker.Publish(eventstream.Event{Type: eventstream.ReplicaPhaseChanged, Volume: "pvc-2"})
+	broker.Publish(eventstream.Event{Type: eventstream.BackupCompleted, Volume: "pvc-1", To: "Done"})
+
+	reader := bufio.NewReader(resp.Body)
+	for _, expected := range []struct {
+		id        string
+		eventType eventstream.Type
+	}{
+		{"uid-1.1-2", eventstream.ReplicaRebuildProgress},
+		{"uid-1.1-4", eventstream.BackupCompleted},
+	} {
+		fields := map[string]string{}
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatalf("failed to read event stream: %v", err)
+			}
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				break
+			}
+			kv := strings.SplitN(line, ": ", 2)
+			fields[kv[0]] = kv[1]
+		}
+		if fields["id"] != expected.id || fields["event"] != string(expected.eventType) {
+			t.Fatalf("expected event %s %s but got %v", expected.id, expected.eventType, fields)
+		}
+		e := eventstream.Event{}
+		if err := json.Unmarshal([]byte(fields["data"]), &e); err != nil {
+			t.Fatalf("failed to decode event data %q: %v", fields["data"], err)
+		}
+		if e.Volume != "pvc-1" || e.Type != expected.eventType {
+			t.Errorf("unexpected event data %+v", e)
+		}
+	}
+}
+
+func TestEventsEndPointErrors(t *testing.T) {
+	cvcServer := NewCVCServer(server.DefaultServerConfig(), os.Stdout)
+	s := &HTTPServer{cvcServer: cvcServer, mux: http.NewServeMux()}
+	s.registerHandlers()
+
+	rec := httptest.NewRecorder()
+	s.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/latest/events", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 without event broker but got %d", rec.Code)
+	}
+
+	cvcServer.WithEventBroker(eventstream.NewBroker("uid-1.1", 0))
+	rec = httptest.NewRecorder()
+	s.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/latest/events", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405 for POST but got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/latest/events?lastEventID=abc", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid last event id but got %d", rec.Code)
+	}
+}
@@ -170,6 +170,10 @@ func (s *HTTPServer) registerHandlers() {
 
 	// Request w.r.t to restore is handled here
 	s.mux.HandleFunc("/latest/restore/", s.wrap(s.restoreV1alpha1SpecificRequest))
+
+	// Pool, replica and backup events are streamed here, the handler isn't
+	// wrapped since the response is streamed instead of encoded
+	s.mux.HandleFunc("/latest/events", s.eventsRequest)
 }
 
 // wrap is a convenient method used to wrap the handler function &
@@ -30,8 +30,10 @@ import (
 	openebsapis "github.com/openebs/api/v3/pkg/apis/openebs.io/v1alpha1"
 	cstortypes "github.com/openebs/api/v3/pkg/apis/types"
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	"github.com/openebs/cstor-operators/pkg/tracing"
 	cstorversion "github.com/openebs/cstor-operators/pkg/version"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
@@ -75,14 +77,24 @@ func (s *HTTPServer) restoreV1alpha1SpecificRequest(
 }
 
 // Create is http handler which handles restore-create request
-func (rOps *restoreAPIOps) create() (interface{}, error) {
-	var err error
+func (rOps *restoreAPIOps) create() (_ interface{}, err error) {
 	restore := &cstorapis.CStorRestore{}
 	err = decodeBody(rOps.req, restore)
 	if err != nil {
 		return nil, err
 	}
 
+	// restore CR and the CVC created for restore carry the trace context so
+	// that the trace of restore request includes provisioning of the volume
+	// and restore of replicas
+	ctx, span := tracing.Start(tracing.ExtractHTTP(rOps.req.Context(), rOps.req.Header),
+		restore, "RestoreVolume",
+		attribute.String("volume", restore.Spec.VolumeName),
+		attribute.String("restore", restore.Spec.RestoreName),
+		attribute.Bool("local", restore.Spec.Local))
+	defer func() { tracing.End(span, err) }()
+	tracing.Inject(ctx, restore)
+
 	err = validateCreateRestoreRequest(restore)
 	if err != nil {
 		return nil, CodedError(400, fmt.Sprintf("restore create validation failed error: {%s}", err.Error()))
@@ -415,6 +427,7 @@ func (rOps *restoreAPIOps) buildCStorVolumeConfig(
 		},
 	}
 
+	tracing.CopyAnnotations(restoreObj.GetAnnotations(), cvcObj.Annotations)
 	return cvcObj, nil
 }
 
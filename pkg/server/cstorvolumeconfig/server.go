@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	"github.com/openebs/cstor-operators/pkg/eventstream"
 	server "github.com/openebs/cstor-operators/pkg/server"
 	"github.com/openebs/cstor-operators/pkg/snapshot"
 	"k8s.io/client-go/kubernetes"
@@ -44,6 +45,10 @@ type CVCServer struct {
 
 	// snapshotter is used to perform snapshot operations on Volumes
 	snapshotter snapshot.Snapshotter
+
+	// eventBroker publishes the pool, replica and backup events streamed
+	// by the server
+	eventBroker *eventstream.Broker
 }
 
 // NewCVCServer is used to create a new CVC server
@@ -76,6 +81,12 @@ func (cs *CVCServer) WithSnapshotter(snapshotter snapshot.Snapshotter) *CVCServe
 	return cs
 }
 
+// WithEventBroker sets the eventBroker with provided argument
+func (cs *CVCServer) WithEventBroker(broker *eventstream.Broker) *CVCServer {
+	cs.eventBroker = broker
+	return cs
+}
+
 // Shutdown is used to terminate CVCServer
 func (cs *CVCServer) Shutdown() {
 
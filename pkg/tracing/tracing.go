@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing exports OpenTelemetry traces of the volume operations over
+// OTLP. An operation spans multiple components e.g. provisioning a volume
+// crosses the CVC controller, the pool managers and the volume manager, so the
+// trace context is propagated from one component to the next via the
+// annotations of the objects created or updated for the operation.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// EndpointEnvVar is the OTLP endpoint to which the traces are exported
+	// e.g http://otel-collector.observability:4318, tracing is disabled if
+	// the endpoint is not set
+	EndpointEnvVar = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// TracesEndpointEnvVar is the OTLP endpoint specific to traces which
+	// takes precedence over EndpointEnvVar
+	TracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	// annotationPrefix is prefixed to the keys of trace context i.e
+	// traceparent and tracestate when stored in object annotations
+	annotationPrefix = "cstor.openebs.io/"
+
+	tracerName = "github.com/openebs/cstor-operators"
+)
+
+var (
+	// propagator encodes the trace context in W3C trace context format
+	propagator = propagation.TraceContext{}
+
+	// envVars are passed on by the operators to the managers they deploy so
+	// that the managers export traces to the same collector
+	envVars = []string{
+		EndpointEnvVar,
+		TracesEndpointEnvVar,
+		"OTEL_EXPORTER_OTLP_HEADERS",
+		"OTEL_EXPORTER_OTLP_INSECURE",
+		"OTEL_TRACES_SAMPLER",
+		"OTEL_TRACES_SAMPLER_ARG",
+	}
+)
+
+// Init registers the OTLP exporter of traces for the given component if
+// the endpoint is configured and returns the func which flushes the pending
+// spans on shutdown. The exporter is configured via the standard OTEL_*
+// environment variables.
+func Init(serviceName string) (func(context.Context) error, error) {
+	if !IsEnabled() {
+		klog.Infof("Tracing disabled, %s not set", EndpointEnvVar)
+		return func(context.Context) error { return nil }, nil
+	}
+	ctx := context.Background()
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	klog.Infof("Tracing enabled for %s", serviceName)
+	return provider.Shutdown, nil
+}
+
+// IsEnabled returns true if the traces are exported
+func IsEnabled() bool {
+	return os.Getenv(EndpointEnvVar) != "" || os.Getenv(TracesEndpointEnvVar) != ""
+}
+
+// Env returns the tracing configuration of this component to be passed on
+// to the containers it deploys
+func Env() []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, name := range envVars {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, corev1.EnvVar{Name: name, Value: value})
+		}
+	}
+	return env
+}
+
+// Start starts a span of the operation on the given object. The span is a
+// child of the trace context stored in the object annotations if any e.g.
+// the replica creation span is a child of the provisioning span of the CVC
+// controller, otherwise a new trace is started.
+func Start(ctx context.Context, obj metav1.Object, spanName string,
+	attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if obj != nil {
+		ctx = Extract(ctx, obj.GetAnnotations())
+		attrs = append(attrs,
+			attribute.String("k8s.object.name", obj.GetName()),
+			attribute.String("k8s.object.namespace", obj.GetNamespace()),
+		)
+	}
+	return otel.Tracer(tracerName).Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// End records the error of the operation if any and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject stores the trace context of ctx in the annotations of the object so
+// that the component reconciling the object continues the trace
+func Inject(ctx context.Context, obj metav1.Object) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	propagator.Inject(ctx, annotationCarrier(annotations))
+	obj.SetAnnotations(annotations)
+}
+
+// Extract returns ctx with the trace context stored in the annotations
+func Extract(ctx context.Context, annotations map[string]string) context.Context {
+	if annotations == nil {
+		return ctx
+	}
+	return propagator.Extract(ctx, annotationCarrier(annotations))
+}
+
+// CopyAnnotations copies the trace context annotations from src to dst so
+// that the object built from another continues its trace
+func CopyAnnotations(src, dst map[string]string) {
+	for _, key := range propagator.Fields() {
+		if value, ok := src[annotationPrefix+key]; ok {
+			dst[annotationPrefix+key] = value
+		}
+	}
+}
+
+// ExtractHTTP returns ctx with the trace context of the request headers so
+// that the operations requested by e.g. the velero plugin join its trace
+func ExtractHTTP(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// annotationCarrier adapts the object annotations to the propagator
+type annotationCarrier map[string]string
+
+// Get returns the value of trace context key
+func (c annotationCarrier) Get(key string) string {
+	return c[annotationPrefix+key]
+}
+
+// Set sets the value of trace context key
+func (c annotationCarrier) Set(key, value string) {
+	c[annotationPrefix+key] = value
+}
+
+// Keys returns the trace context keys stored in the annotations
+func (c annotationCarrier) Keys() []string {
+	keys := []string{}
+	for _, key := range propagator.Fields() {
+		if _, ok := c[annotationPrefix+key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func withSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(provider) })
+	return recorder
+}
+
+func TestPropagationViaAnnotations(t *testing.T) {
+	recorder := withSpanRecorder(t)
+
+	// CVC controller starts the provisioning trace and carries it on the
+	// replica built from the cvc
+	cvc := &cstor.CStorVolumeConfig{ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: "openebs"}}
+	ctx, span := Start(context.TODO(), cvc, "CreateVolume")
+	Inject(ctx, cvc)
+	cvrAnnotations := map[string]string{"cstorpoolinstance.openebs.io/hostname": "node-1"}
+	CopyAnnotations(cvc.GetAnnotations(), cvrAnnotations)
+	cvr := &cstor.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1-cspi-1", Namespace: "openebs", Annotations: cvrAnnotations},
+	}
+	End(span, nil)
+
+	// pool manager continues the trace from the replica
+	_, replicaSpan := Start(context.TODO(), cvr, "CreateVolumeReplica")
+	End(replicaSpan, fmt.Errorf("zfs create failed"))
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans but got %d", len(spans))
+	}
+	parent, child := spans[0], spans[1]
+	if child.SpanContext().TraceID() != parent.SpanContext().TraceID() {
+		t.Errorf("expected replica span in trace %s but got %s",
+			parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	}
+	if child.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("expected replica span to be child of %s but got %s",
+			parent.SpanContext().SpanID(), child.Parent().SpanID())
+	}
+	if child.Status().Code != codes.Error {
+		t.Errorf("expected error status on replica span but got %v", child.Status())
+	}
+	if parent.Parent().IsValid() {
+		t.Errorf("expected provisioning span to start a new trace")
+	}
+}
+
+func TestExtractHTTP(t *testing.T) {
+	recorder := withSpanRecorder(t)
+	header := http.Header{}
+	header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+	_, span := Start(ExtractHTTP(context.TODO(), header), nil, "BackupVolume")
+	End(span, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span but got %d", len(spans))
+	}
+	if got := spans[0].SpanContext().TraceID().String(); got != "0af7651916cd43dd8448eb211c80319c" {
+		t.Errorf("expected backup span to join the trace of request but got %s", got)
+	}
+}
+
+func TestInjectWithoutSpan(t *testing.T) {
+	cvc := &cstor.CStorVolumeConfig{}
+	Inject(trace.ContextWithSpanContext(context.TODO(), trace.SpanContext{}), cvc)
+	if len(cvc.GetAnnotations()) != 0 {
+		t.Errorf("expected no annotations without a valid span but got %v", cvc.GetAnnotations())
+	}
+}
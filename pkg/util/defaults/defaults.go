@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaults holds the default values of CSPC and CVC specs. These are
+// applied up front by the mutating webhook and again by the controllers for
+// objects admitted while the webhook was unavailable.
+package defaults
+
+import (
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// ROThresholdLimit is the default value in form of percentage for
+	// ROThreshold limit of a pool
+	ROThresholdLimit = 85
+	// Compression is the default compression of a pool
+	Compression = "lz4"
+	// QueueDepth represents the queue size at iSCSI target which limits
+	// the ongoing IO count from client.
+	QueueDepth = "32"
+	// IOWorkers represents default (luWorker) number of threads that are
+	// working on queue
+	IOWorkers = int64(6)
+)
+
+// SetPoolConfigDefaults defaults the pool config fields which don't depend
+// on the CSPC level defaults. Fields like resources and tolerations are left
+// empty so that the pool keeps following the CSPC level defaults when they
+// change.
+func SetPoolConfigDefaults(poolConfig *cstor.PoolConfig) {
+	if poolConfig.ROThresholdLimit == nil {
+		roThresholdLimit := ROThresholdLimit
+		poolConfig.ROThresholdLimit = &roThresholdLimit
+	}
+	if poolConfig.Compression == "" {
+		poolConfig.Compression = Compression
+	}
+}
+
+// SetCSPCDefaults defaults the pool config of all the pools of CSPC
+func SetCSPCDefaults(cspc *cstor.CStorPoolCluster) {
+	for i := range cspc.Spec.Pools {
+		SetPoolConfigDefaults(&cspc.Spec.Pools[i].PoolConfig)
+	}
+}
+
+type policyOptFuncs func(*cstor.CStorVolumePolicySpec, cstor.CStorVolumePolicySpec)
+
+// SetVolumePolicyDefaults sets the defaults on the fields of volume policy
+// spec which are not provided by the user
+func SetVolumePolicyDefaults(policy *cstor.CStorVolumePolicySpec) {
+	defaultPolicy := VolumePolicySpec()
+	optFuncs := []policyOptFuncs{
+		defaultTargetPolicy, defaultReplicaPolicy,
+	}
+	for _, o := range optFuncs {
+		o(policy, defaultPolicy)
+	}
+}
+
+// defaultTargetPolicy configure the default volume target deployment related policies
+func defaultTargetPolicy(policy *cstor.CStorVolumePolicySpec, defaultPolicy cstor.CStorVolumePolicySpec) {
+	if policy.Target.Resources == nil {
+		policy.Target.Resources = defaultPolicy.Target.Resources
+	}
+	if policy.Target.AuxResources == nil {
+		policy.Target.AuxResources = defaultPolicy.Target.AuxResources
+	}
+	if policy.Target.IOWorkers == 0 {
+		policy.Target.IOWorkers = defaultPolicy.Target.IOWorkers
+	}
+	if policy.Target.QueueDepth == "" {
+		policy.Target.QueueDepth = defaultPolicy.Target.QueueDepth
+	}
+}
+
+// defaultReplicaPolicy configure the default volume replica related policies
+func defaultReplicaPolicy(policy *cstor.CStorVolumePolicySpec, defaultPolicy cstor.CStorVolumePolicySpec) {
+}
+
+// VolumePolicySpec returns the default cstor volume policy spec.
+func VolumePolicySpec() cstor.CStorVolumePolicySpec {
+	return cstor.CStorVolumePolicySpec{
+		Target: cstor.TargetSpec{
+			Resources:    zeroResources(),
+			AuxResources: zeroResources(),
+			QueueDepth:   QueueDepth,
+			IOWorkers:    IOWorkers,
+		},
+		Replica: cstor.ReplicaSpec{},
+	}
+}
+
+func zeroResources() *corev1.ResourceRequirements {
+	return &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("0"),
+			corev1.ResourceMemory: resource.MustParse("0"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("0"),
+			corev1.ResourceMemory: resource.MustParse("0"),
+		},
+	}
+}
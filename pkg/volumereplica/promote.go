@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumereplica
+
+import (
+	"strings"
+
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	zcmd "github.com/openebs/cstor-operators/pkg/zcmd"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// PromoteKey is the annotation on CVR used by CVC controller to request
+	// the pool-manager to promote the clone replica and by pool-manager to
+	// report the completion
+	PromoteKey = "cstorvolumereplica.openebs.io/promote"
+	// PromoteRequested is the value of PromoteKey when promotion is pending
+	PromoteRequested = "requested"
+	// PromoteCompleted is the value of PromoteKey once replica is promoted
+	PromoteCompleted = "completed"
+
+	// noOrigin is the value of zfs origin property for non clone datasets
+	noOrigin = "-"
+)
+
+// PromoteVolume promotes the clone volume so that it no longer depends on
+// the origin snapshot of its source volume. Volumes which are not clones,
+// e.g. clones seeded from other pools, are left as is.
+func PromoteVolume(fullVolName string) error {
+	values, err := GetListOfPropertyValues(fullVolName, []string{"origin"}, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get origin of volume %s", fullVolName)
+	}
+	if len(values) == 0 || strings.TrimSpace(values[0]) == noOrigin {
+		klog.Infof("Volume %s is not a clone, skipping promote", fullVolName)
+		return nil
+	}
+
+	ret, err := zcmd.NewVolumePromote().
+		WithDataset(fullVolName).
+		Execute()
+	if err != nil {
+		alertlog.Alert(alertlog.VolumeReplicaPromoteFailure, "Failed to promote CStor volume replica clone",
+			alertlog.Dataset(fullVolName),
+			"rname", fullVolName,
+		)
+		return errors.Wrapf(err, "failed to promote volume %s: %s", fullVolName, string(ret))
+	}
+	alertlog.Alert(alertlog.VolumeReplicaPromoteSuccess, "Successfully promoted CStor volume replica clone",
+		alertlog.Dataset(fullVolName),
+		"rname", fullVolName,
+	)
+	return nil
+}
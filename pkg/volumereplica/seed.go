@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumereplica
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"strconv"
+	"time"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	"github.com/openebs/cstor-operators/pkg/zcmd/bin"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// Clone volumes whose source snapshot doesn't exist on the pool where the
+// clone replica is placed are seeded by streaming the snapshot from a pool
+// that holds a source replica. The handshake is driven through annotations:
+//
+//  1. The CVC controller creates the clone CVR with SeedSourceKey pointing to
+//     a healthy source CVR and sets SeedSnapshotKey along with a random
+//     SeedTokenKey on that source CVR.
+//  2. The pool-manager owning the source CVR serves `zfs send` of the snapshot
+//     on a TCP port and publishes the address in SeedEndpointKey.
+//  3. The pool-manager owning the clone CVR connects to the endpoint, sends
+//     the token, runs `zfs recv` and sets the replica properties on the
+//     received volume. The source streams only to peers presenting the token
+//     from the pool of a clone replica waiting for it.
+const (
+	// SeedSourceKey is the annotation on a clone CVR holding the name of the
+	// source CVR from which the clone should be seeded
+	SeedSourceKey = "cstorvolumereplica.openebs.io/seed-source"
+	// SeedSnapshotKey is the annotation on a source CVR holding the name of
+	// the snapshot that has to be streamed to the clone replicas
+	SeedSnapshotKey = "cstorvolumereplica.openebs.io/seed-snapshot"
+	// SeedEndpointKey is the annotation on a source CVR holding the
+	// <ip>:<port> on which the snapshot stream is served
+	SeedEndpointKey = "cstorvolumereplica.openebs.io/seed-endpoint"
+	// SeedTokenKey is the annotation on a source CVR holding the token the
+	// clone replicas must present to receive the snapshot stream
+	SeedTokenKey = "cstorvolumereplica.openebs.io/seed-token"
+
+	// SeedPortStart is the first port used to serve snapshot streams
+	SeedPortStart = 7800
+	// SeedPortCount is the number of ports available to serve snapshot
+	// streams concurrently from a single pool
+	SeedPortCount = 32
+	// SeedAcceptTimeout is the time to wait for a clone replica to connect
+	// before the stream listener is closed
+	SeedAcceptTimeout = 10 * time.Minute
+	// SeedDialTimeout is the time to wait while connecting to the stream
+	// listener of the source replica
+	SeedDialTimeout = 30 * time.Second
+	// SeedHandshakeTimeout is the time to wait for a connected peer to send
+	// the token before the connection is dropped
+	SeedHandshakeTimeout = 30 * time.Second
+	// seedTokenBytes is the number of random bytes of a seed token
+	seedTokenBytes = 32
+)
+
+// NewSeedToken returns a random token to authenticate the clone replicas
+// receiving a snapshot stream
+func NewSeedToken() (string, error) {
+	b := make([]byte, seedTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate seed token")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ServeVolumeSeed listens on the given port and streams the snapshot of the
+// given volume to the first peer that is allowed by isPeerAllowed and sends
+// the given token. It blocks until the transfer completes or no such peer
+// connects within SeedAcceptTimeout.
+func ServeVolumeSeed(fullVolName, snapName, token string, port int,
+	isPeerAllowed func(ip string) bool) error {
+	if token == "" {
+		return errors.Errorf("no token to serve %s@%s", fullVolName, snapName)
+	}
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(port))
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on port %d", port)
+	}
+	defer listener.Close()
+
+	err = listener.(*net.TCPListener).SetDeadline(time.Now().Add(SeedAcceptTimeout))
+	if err != nil {
+		return errors.Wrapf(err, "failed to set deadline on port %d", port)
+	}
+
+	var conn net.Conn
+	for {
+		conn, err = listener.Accept()
+		if err != nil {
+			return errors.Wrapf(err, "no clone replica connected for %s@%s", fullVolName, snapName)
+		}
+		err = authenticateSeedPeer(conn, token, isPeerAllowed)
+		if err == nil {
+			break
+		}
+		klog.Warningf("Rejected %s requesting snapshot %s@%s: %v",
+			conn.RemoteAddr(), fullVolName, snapName, err)
+		conn.Close()
+	}
+	defer conn.Close()
+
+	klog.Infof("Sending snapshot %s@%s to %s", fullVolName, snapName, conn.RemoteAddr())
+	// #nosec
+	cmd := exec.Command(bin.ZFS, BackupCmd, fullVolName+"@"+snapName)
+	cmd.Stdout = conn
+	stderr, err := runWithStderr(cmd)
+	if err != nil {
+		alertlog.Alert(alertlog.VolumeReplicaSeedSendFailure, "Failed to send snapshot to CStor volume replica clone",
+			alertlog.Dataset(fullVolName),
+			"rname", fullVolName,
+		)
+		return errors.Wrapf(err, "failed to send %s@%s: %s", fullVolName, snapName, stderr)
+	}
+	return nil
+}
+
+// authenticateSeedPeer returns an error if the connected peer isn't allowed
+// or doesn't send the expected token
+func authenticateSeedPeer(conn net.Conn, token string, isPeerAllowed func(ip string) bool) error {
+	host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		return err
+	}
+	if !isPeerAllowed(host) {
+		return errors.Errorf("%s is not a pool of any clone replica", host)
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(SeedHandshakeTimeout)); err != nil {
+		return err
+	}
+	received := make([]byte, len(token))
+	if _, err := io.ReadFull(conn, received); err != nil {
+		return errors.Wrap(err, "failed to read token")
+	}
+	if subtle.ConstantTimeCompare(received, []byte(token)) != 1 {
+		return errors.New("invalid token")
+	}
+	return conn.SetReadDeadline(time.Time{})
+}
+
+// ReceiveVolumeSeed connects to the endpoint served by the source replica,
+// authenticates with the given token and receives the snapshot stream as the
+// given volume.
+func ReceiveVolumeSeed(endpoint, token, fullVolName string) error {
+	conn, err := net.DialTimeout("tcp", endpoint, SeedDialTimeout)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to seed endpoint %s", endpoint)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(token)); err != nil {
+		return errors.Wrapf(err, "failed to send token to seed endpoint %s", endpoint)
+	}
+
+	klog.Infof("Receiving clone volume %s from %s", fullVolName, endpoint)
+	// #nosec
+	cmd := exec.Command(bin.ZFS, RestoreCmd, "-F", fullVolName)
+	cmd.Stdin = conn
+	stderr, err := runWithStderr(cmd)
+	if err != nil {
+		alertlog.Alert(alertlog.VolumeReplicaSeedReceiveFailure, "Failed to receive CStor volume replica clone",
+			alertlog.Dataset(fullVolName),
+			"rname", fullVolName,
+		)
+		return errors.Wrapf(err, "failed to receive %s: %s", fullVolName, stderr)
+	}
+	return nil
+}
+
+// SetSeededVolumeProperties sets the properties on a received volume that a
+// `zfs create` or `zfs clone` would have set for the given CVR.
+func SetSeededVolumeProperties(
+	cStorVolumeReplica *cstor.CStorVolumeReplica, fullVolName string, quorum bool) error {
+	cmd := buildSeededVolumePropertiesCommand(cStorVolumeReplica, fullVolName, quorum)
+	stdoutStderr, err := RunnerVar.RunCombinedOutput(VolumeReplicaOperator, cmd...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set properties on %s: %s", fullVolName, string(stdoutStderr))
+	}
+	alertlog.Alert(alertlog.VolumeReplicaCloneCreateSuccess, "Successfully created CStor volume replica clone",
+		alertResource("CStorVolumeReplica", cStorVolumeReplica),
+		"rname", fullVolName,
+	)
+	return nil
+}
+
+// buildSeededVolumePropertiesCommand returns the zfs set command for a
+// received volume as a string array
+func buildSeededVolumePropertiesCommand(
+	cStorVolumeReplica *cstor.CStorVolumeReplica, fullVolName string, quorum bool) []string {
+	quorumValue := "quorum=on"
+	if !quorum {
+		quorumValue = "quorum=off"
+	}
+
+	setCmd := []string{"set",
+		"compression=on",
+		quorumValue,
+		"io.openebs:targetip=" + cStorVolumeReplica.Spec.TargetIP,
+		"io.openebs:volname=" + cStorVolumeReplica.ObjectMeta.Name,
+		"io.openebs:zvol_replica_id=" + fmt.Sprintf("%v", cStorVolumeReplica.Spec.ReplicaID),
+	}
+	if len(cStorVolumeReplica.Spec.ZvolWorkers) != 0 {
+		setCmd = append(setCmd,
+			"io.openebs:zvol_workers="+cStorVolumeReplica.Spec.ZvolWorkers,
+		)
+	}
+	return append(setCmd, fullVolName)
+}
+
+// runWithStderr runs the given command and returns its stderr
+func runWithStderr(cmd *exec.Cmd) (string, error) {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	return stderr.String(), err
+}
@@ -252,6 +252,11 @@ func CheckValidVolumeReplica(cVR *cstor.CStorVolumeReplica) error {
 	return nil
 }
 
+// alertResource returns the resource of the alerts raised for the given object
+func alertResource(kind string, obj metav1.Object) alertlog.Resource {
+	return alertlog.NewResource(cstor.SchemeGroupVersion.WithKind(kind), obj)
+}
+
 // CreateVolumeReplica creates cStor replica(zfs volumes).
 func CreateVolumeReplica(cStorVolumeReplica *cstor.CStorVolumeReplica, fullVolName string, quorum bool) error {
 	var cmd []string
@@ -281,16 +286,14 @@ func CreateVolumeReplica(cStorVolumeReplica *cstor.CStorVolumeReplica, fullVolNa
 	if err != nil {
 		if isClone {
 			klog.Errorf("Unable to create clone volume: %s for snapshot %s. error : %v", fullVolName, snapName, string(stdoutStderr))
-			alertlog.Logger.Errorw("",
-				"eventcode", "cstor.volume.replica.clone.create.failure",
-				"msg", "Failed to create CStor volume replica clone",
+			alertlog.Alert(alertlog.VolumeReplicaCloneCreateFailure, "Failed to create CStor volume replica clone",
+				alertResource("CStorVolumeReplica", cStorVolumeReplica),
 				"rname", fullVolName,
 			)
 		} else {
 			klog.Errorf("Unable to create volume %s. error : %v", fullVolName, string(stdoutStderr))
-			alertlog.Logger.Errorw("",
-				"eventcode", "cstor.volume.replica.create.failure",
-				"msg", "Failed to create CStor volume replica",
+			alertlog.Alert(alertlog.VolumeReplicaCreateFailure, "Failed to create CStor volume replica",
+				alertResource("CStorVolumeReplica", cStorVolumeReplica),
 				"rname", fullVolName,
 			)
 		}
@@ -299,15 +302,13 @@ func CreateVolumeReplica(cStorVolumeReplica *cstor.CStorVolumeReplica, fullVolNa
 	}
 
 	if isClone {
-		alertlog.Logger.Infow("",
-			"eventcode", "cstor.volume.replica.clone.create.success",
-			"msg", "Successfully created CStor volume replica clone",
+		alertlog.Alert(alertlog.VolumeReplicaCloneCreateSuccess, "Successfully created CStor volume replica clone",
+			alertResource("CStorVolumeReplica", cStorVolumeReplica),
 			"rname", fullVolName,
 		)
 	} else {
-		alertlog.Logger.Infow("",
-			"eventcode", "cstor.volume.replica.create.success",
-			"msg", "Successfully created CStor volume replica",
+		alertlog.Alert(alertlog.VolumeReplicaCreateSuccess, "Successfully created CStor volume replica",
+			alertResource("CStorVolumeReplica", cStorVolumeReplica),
 			"rname", fullVolName,
 		)
 	}
@@ -443,16 +444,14 @@ func CreateVolumeBackup(bkp *cstor.CStorBackup) error {
 	}
 	// In case if any error occured then return error
 	if err != nil {
-		alertlog.Logger.Errorw("",
-			"eventcode", "cstor.volume.backup.create.failure",
-			"msg", "Failed to create backup CStor volume",
+		alertlog.Alert(alertlog.VolumeBackupCreateFailure, "Failed to create backup CStor volume",
+			alertResource("CStorBackup", bkp),
 			"rname", bkp.Spec.VolumeName,
 		)
 		return errors.Wrapf(err, "error: %s", string(stdoutStderr))
 	}
-	alertlog.Logger.Infow("",
-		"eventcode", "cstor.volume.backup.create.success",
-		"msg", "Successfully created backup CStor volume",
+	alertlog.Alert(alertlog.VolumeBackupCreateSuccess, "Successfully created backup CStor volume",
+		alertResource("CStorBackup", bkp),
 		"rname", bkp.Spec.VolumeName,
 	)
 	return nil
@@ -498,15 +497,13 @@ func CreateVolumeRestore(rst *cstor.CStorRestore) error {
 		break
 	}
 	if err != nil {
-		alertlog.Logger.Errorw("",
-			"eventcode", "cstor.volume.restore.failure",
-			"msg", "Failed to restore CStor volume",
+		alertlog.Alert(alertlog.VolumeRestoreFailure, "Failed to restore CStor volume",
+			alertResource("CStorRestore", rst),
 			"rname", rst.Spec.VolumeName,
 		)
 	} else {
-		alertlog.Logger.Infow("",
-			"eventcode", "cstor.volume.restore.success",
-			"msg", "Successfully restored CStor volume",
+		alertlog.Alert(alertlog.VolumeRestoreSuccess, "Successfully restored CStor volume",
+			alertResource("CStorRestore", rst),
 			"rname", rst.Spec.VolumeName,
 		)
 	}
@@ -563,16 +560,14 @@ func DeleteVolume(fullVolName string) error {
 			return nil
 		}
 		klog.Errorf("Unable to delete volume : %v", string(stdoutStderr))
-		alertlog.Logger.Errorw("",
-			"eventcode", "cstor.volume.delete.failure",
-			"msg", "Failed to delete CStor volume",
+		alertlog.Alert(alertlog.VolumeDeleteFailure, "Failed to delete CStor volume",
+			alertlog.Dataset(fullVolName),
 			"rname", fullVolName,
 		)
 		return errors.Wrapf(err, "failed to delete volume.. %s", string(stdoutStderr))
 	}
-	alertlog.Logger.Infow("",
-		"eventcode", "cstor.volume.delete.success",
-		"msg", "Successfully deleted CStor volume",
+	alertlog.Alert(alertlog.VolumeDeleteSuccess, "Successfully deleted CStor volume",
+		alertlog.Dataset(fullVolName),
 		"rname", fullVolName,
 	)
 	return nil
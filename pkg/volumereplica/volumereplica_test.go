@@ -18,6 +18,7 @@ package volumereplica
 import (
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
 	"reflect"
@@ -547,3 +548,110 @@ func TestVolumeCapacity(t *testing.T) {
 		})
 	}
 }
+
+// TestBuildSeededVolumePropertiesCommand tests buildSeededVolumePropertiesCommand function.
+func TestBuildSeededVolumePropertiesCommand(t *testing.T) {
+	testCases := map[string]struct {
+		cvr         *cstor.CStorVolumeReplica
+		quorum      bool
+		expectedCmd []string
+	}{
+		"When zvol workers are not set": {
+			cvr: &cstor.CStorVolumeReplica{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc1-pool1"},
+				Spec: cstor.CStorVolumeReplicaSpec{
+					TargetIP:  "10.0.0.1",
+					ReplicaID: "ABCD",
+				},
+			},
+			quorum: true,
+			expectedCmd: []string{"set", "compression=on", "quorum=on",
+				"io.openebs:targetip=10.0.0.1", "io.openebs:volname=pvc1-pool1",
+				"io.openebs:zvol_replica_id=ABCD", "cstor-pool1/pvc1"},
+		},
+		"When zvol workers are set and quorum is off": {
+			cvr: &cstor.CStorVolumeReplica{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc1-pool1"},
+				Spec: cstor.CStorVolumeReplicaSpec{
+					TargetIP:    "10.0.0.1",
+					ReplicaID:   "ABCD",
+					ZvolWorkers: "4",
+				},
+			},
+			quorum: false,
+			expectedCmd: []string{"set", "compression=on", "quorum=off",
+				"io.openebs:targetip=10.0.0.1", "io.openebs:volname=pvc1-pool1",
+				"io.openebs:zvol_replica_id=ABCD", "io.openebs:zvol_workers=4",
+				"cstor-pool1/pvc1"},
+		},
+	}
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotCmd := buildSeededVolumePropertiesCommand(test.cvr, "cstor-pool1/pvc1", test.quorum)
+			if !reflect.DeepEqual(gotCmd, test.expectedCmd) {
+				t.Errorf("Test case failed as expected command %v but got %v", test.expectedCmd, gotCmd)
+			}
+		})
+	}
+}
+
+func TestAuthenticateSeedPeer(t *testing.T) {
+	token, err := NewSeedToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := map[string]struct {
+		sentToken   string
+		peerAllowed bool
+		expectErr   bool
+	}{
+		"When peer is allowed and sends the token": {
+			sentToken:   token,
+			peerAllowed: true,
+		},
+		"When peer is allowed and sends other token": {
+			sentToken:   token[1:] + "0",
+			peerAllowed: true,
+			expectErr:   true,
+		},
+		"When peer isn't a pool of any clone replica": {
+			sentToken: token,
+			expectErr: true,
+		},
+	}
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer listener.Close()
+			go func() {
+				conn, err := net.Dial("tcp", listener.Addr().String())
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				_, _ = conn.Write([]byte(test.sentToken))
+				_, _ = ioutil.ReadAll(conn)
+			}()
+			conn, err := listener.Accept()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			var gotIP string
+			err = authenticateSeedPeer(conn, token, func(ip string) bool {
+				gotIP = ip
+				return test.peerAllowed
+			})
+			if gotIP != "127.0.0.1" {
+				t.Errorf("expected peer ip 127.0.0.1 to be checked but got %q", gotIP)
+			}
+			if test.expectErr != (err != nil) {
+				t.Errorf("Test case failed as expected error %v but got %v", test.expectErr, err)
+			}
+		})
+	}
+}
@@ -63,6 +63,12 @@ func (ar *AdmissionResponse) UnSetAllowed() *AdmissionResponse {
 	return ar
 }
 
+// WithWarnings appends the warnings returned to the API client.
+func (ar *AdmissionResponse) WithWarnings(warnings ...string) *AdmissionResponse {
+	ar.AR.Warnings = append(ar.AR.Warnings, warnings...)
+	return ar
+}
+
 // BuildForAPIObject builds for api admission response object.
 func BuildForAPIObject(ar *v1.AdmissionResponse) *AdmissionResponse {
 	return &AdmissionResponse{AR: ar}
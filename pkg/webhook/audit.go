@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// verdictAllowed is the verdict of the admitted requests
+	verdictAllowed = "allowed"
+	// verdictDenied is the verdict of the rejected requests
+	verdictDenied = "denied"
+)
+
+var (
+	// admissionRequestsTotal counts the admission decisions per webhook
+	// i.e validate or mutate, kind of the object, operation and verdict
+	admissionRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cstor",
+			Subsystem: "admission_webhook",
+			Name:      "requests_total",
+			Help:      "Total number of admission requests handled by the webhook per verdict",
+		},
+		[]string{"webhook", "kind", "operation", "verdict"},
+	)
+	// admissionRequestDuration observes the time taken to decide on the
+	// admission requests
+	admissionRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "cstor",
+			Subsystem: "admission_webhook",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken by the webhook to decide on the admission requests",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"webhook", "kind"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(admissionRequestsTotal, admissionRequestDuration)
+}
+
+// auditAdmission records the admission decision as a structured audit
+// entry and updates the admission metrics
+func auditAdmission(webhookName string, req *v1.AdmissionRequest,
+	resp *v1.AdmissionResponse, latency time.Duration) {
+	var kind, operation, user string
+	var resource alertlog.Resource
+	if req != nil {
+		kind = req.Kind.Kind
+		operation = string(req.Operation)
+		user = req.UserInfo.Username
+		resource = alertlog.Resource{
+			APIVersion: schema.GroupVersion{Group: req.Kind.Group, Version: req.Kind.Version}.String(),
+			Kind:       req.Kind.Kind,
+			Name:       req.Name,
+			Namespace:  req.Namespace,
+		}
+	}
+	verdict := verdictDenied
+	var reason string
+	var code int32
+	var warnings int
+	if resp != nil {
+		if resp.Allowed {
+			verdict = verdictAllowed
+		}
+		if resp.Result != nil {
+			reason = resp.Result.Message
+			code = resp.Result.Code
+		}
+		warnings = len(resp.Warnings)
+	}
+
+	admissionRequestsTotal.WithLabelValues(webhookName, kind, operation, verdict).Inc()
+	admissionRequestDuration.WithLabelValues(webhookName, kind).Observe(latency.Seconds())
+
+	eventCode := alertlog.AdmissionRequestAllowed
+	if verdict == verdictDenied {
+		eventCode = alertlog.AdmissionRequestDenied
+	}
+	alertlog.Alert(eventCode, "Admission request "+verdict, resource,
+		"webhook", webhookName,
+		"user", user,
+		"operation", operation,
+		"verdict", verdict,
+		"reason", reason,
+		"code", code,
+		"warnings", warnings,
+		"latency", latency.String(),
+	)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/cstor-operators/pkg/log/alertlog"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	v1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestServeAudit(t *testing.T) {
+	os.Setenv("OPENEBS_NAMESPACE", "openebs")
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := alertlog.Logger
+	alertlog.Logger = zap.New(core).Sugar()
+	defer func() { alertlog.Logger = logger }()
+
+	invalidCSPC := cstor.NewCStorPoolCluster().WithName("cspc-invalid").WithNamespace("openebs")
+	tests := map[string]struct {
+		kind            string
+		object          interface{}
+		expectedVerdict string
+	}{
+		"request of not validated kind is allowed": {
+			kind:            "Pod",
+			object:          map[string]string{},
+			expectedVerdict: verdictAllowed,
+		},
+		"invalid cspc is denied": {
+			kind:            "CStorPoolCluster",
+			object:          invalidCSPC,
+			expectedVerdict: verdictDenied,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().withOpenebsObjects().withKubeObjects()
+			ar := v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "uid-1",
+					Kind:      metav1.GroupVersionKind{Kind: test.kind},
+					Name:      "obj-1",
+					Operation: v1.Create,
+					UserInfo:  authenticationv1.UserInfo{Username: "alice"},
+					Object:    runtime.RawExtension{Raw: serialize(test.object)},
+				},
+			}
+			counter := admissionRequestsTotal.WithLabelValues("validate", test.kind, "CREATE", test.expectedVerdict)
+			before := testutil.ToFloat64(counter)
+			logs.TakeAll()
+
+			r := httptest.NewRequest(http.MethodPost, validationPath, bytes.NewReader(serialize(ar)))
+			r.Header.Set("Content-Type", "application/json")
+			f.wh.Serve(httptest.NewRecorder(), r)
+
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("%s test case failed expected counter to be incremented by 1 but got %v", name, got)
+			}
+			entries := logs.TakeAll()
+			if len(entries) != 1 {
+				t.Fatalf("%s test case failed expected 1 audit entry but got %d", name, len(entries))
+			}
+			fields := entries[0].ContextMap()
+			if fields["verdict"] != test.expectedVerdict || fields["user"] != "alice" ||
+				fields["resource.kind"] != test.kind || fields["resource.name"] != "obj-1" ||
+				fields["eventcode"] != "cstor.admission.request."+test.expectedVerdict {
+				t.Errorf("%s test case failed unexpected audit entry %v", name, fields)
+			}
+		})
+	}
+}
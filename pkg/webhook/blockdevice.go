@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	openebsapis "github.com/openebs/api/v3/pkg/apis/openebs.io/v1alpha1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/api/v3/pkg/util"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// validateBD validates BlockDevice update and delete requests. A block
+// device used by a pool can't be deleted or have its claim released, NDM
+// would otherwise hand it out to someone else while the pool is on it.
+func (wh *webhook) validateBD(ar *v1.AdmissionReview) *v1.AdmissionResponse {
+	req := ar.Request
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	if req.Operation != v1.Update && req.Operation != v1.Delete {
+		return response
+	}
+
+	var oldBD openebsapis.BlockDevice
+	err := json.Unmarshal(req.OldObject.Raw, &oldBD)
+	if err != nil {
+		klog.Errorf("Could not unmarshal blockdevice %s raw object: %v, %+v", req.Name, err, string(req.OldObject.Raw))
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+	if req.Operation == v1.Update {
+		var newBD openebsapis.BlockDevice
+		err = json.Unmarshal(req.Object.Raw, &newBD)
+		if err != nil {
+			klog.Errorf("Could not unmarshal blockdevice %s raw object: %v, %+v", req.Name, err, string(req.Object.Raw))
+			response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+			return response
+		}
+		if !isBDClaimReleased(&oldBD, &newBD) {
+			return response
+		}
+	}
+
+	bdc, err := getBDCOfBD(wh.clientset, oldBD.Namespace, oldBD.Name)
+	if err != nil {
+		klog.Errorf("Could not get bdc of blockdevice %s: %s", oldBD.Name, err.Error())
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+	cspcName := ""
+	if bdc != nil {
+		cspcName = bdc.GetLabels()[types.CStorPoolClusterLabelKey]
+	}
+	cspiName, err := wh.getCSPIUsingBD(oldBD.Namespace, oldBD.Name, cspcName)
+	if err != nil {
+		klog.Errorf("Could not find pool using blockdevice %s: %s", oldBD.Name, err.Error())
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+	if cspiName != "" {
+		err = errors.Errorf("invalid blockdevice %s %s: blockdevice is in use by pool %s",
+			oldBD.Name, req.Operation, cspiName)
+		klog.Error(err)
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusUnprocessableEntity).AR
+	}
+	return response
+}
+
+// validateBDC validates BlockDeviceClaim update and delete requests. A claim
+// of the block device used by a pool can't be deleted, pointed to another
+// block device or detached from its CSPC.
+func (wh *webhook) validateBDC(ar *v1.AdmissionReview) *v1.AdmissionResponse {
+	req := ar.Request
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	if req.Operation != v1.Update && req.Operation != v1.Delete {
+		return response
+	}
+
+	var oldBDC openebsapis.BlockDeviceClaim
+	err := json.Unmarshal(req.OldObject.Raw, &oldBDC)
+	if err != nil {
+		klog.Errorf("Could not unmarshal blockdeviceclaim %s raw object: %v, %+v", req.Name, err, string(req.OldObject.Raw))
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+	if oldBDC.Spec.BlockDeviceName == "" {
+		return response
+	}
+	if req.Operation == v1.Update {
+		var newBDC openebsapis.BlockDeviceClaim
+		err = json.Unmarshal(req.Object.Raw, &newBDC)
+		if err != nil {
+			klog.Errorf("Could not unmarshal blockdeviceclaim %s raw object: %v, %+v", req.Name, err, string(req.Object.Raw))
+			response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+			return response
+		}
+		if !isBDCDetached(&oldBDC, &newBDC) {
+			return response
+		}
+	}
+
+	cspiName, err := wh.getCSPIUsingBD(oldBDC.Namespace, oldBDC.Spec.BlockDeviceName,
+		oldBDC.GetLabels()[types.CStorPoolClusterLabelKey])
+	if err != nil {
+		klog.Errorf("Could not find pool using blockdevice %s: %s", oldBDC.Spec.BlockDeviceName, err.Error())
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+	if cspiName != "" {
+		err = errors.Errorf("invalid blockdeviceclaim %s %s: blockdevice %s is in use by pool %s",
+			oldBDC.Name, req.Operation, oldBDC.Spec.BlockDeviceName, cspiName)
+		klog.Error(err)
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusUnprocessableEntity).AR
+	}
+	return response
+}
+
+// isBDClaimReleased returns true if the update releases the claimed block
+// device or binds it to another claim
+func isBDClaimReleased(oldBD, newBD *openebsapis.BlockDevice) bool {
+	if oldBD.Status.ClaimState != openebsapis.BlockDeviceClaimed {
+		return false
+	}
+	if newBD.Status.ClaimState != openebsapis.BlockDeviceClaimed {
+		return true
+	}
+	if oldBD.Spec.ClaimRef == nil {
+		return false
+	}
+	return newBD.Spec.ClaimRef == nil || newBD.Spec.ClaimRef.Name != oldBD.Spec.ClaimRef.Name
+}
+
+// isBDCDetached returns true if the update takes the claim away from the
+// pool i.e changes the block device or the CSPC of the claim or removes the
+// CSPC finalizer. Updates of the claim under deletion are not considered as
+// the deletion has been admitted already.
+func isBDCDetached(oldBDC, newBDC *openebsapis.BlockDeviceClaim) bool {
+	if newBDC.DeletionTimestamp != nil {
+		return false
+	}
+	if newBDC.Spec.BlockDeviceName != oldBDC.Spec.BlockDeviceName {
+		return true
+	}
+	oldCSPC := oldBDC.GetLabels()[types.CStorPoolClusterLabelKey]
+	if oldCSPC != "" && newBDC.GetLabels()[types.CStorPoolClusterLabelKey] != oldCSPC {
+		return true
+	}
+	return util.ContainsString(oldBDC.Finalizers, types.CSPCFinalizer) &&
+		!util.ContainsString(newBDC.Finalizers, types.CSPCFinalizer)
+}
+
+// getCSPIUsingBD returns the name of the pool instance whose raid groups
+// refer the block device. If cspcName is empty pool instances of all the
+// CSPCs are looked up. Pool instances under deletion are skipped as their
+// claims are cleaned up as part of the deletion.
+func (wh *webhook) getCSPIUsingBD(namespace, bdName, cspcName string) (string, error) {
+	listOpts := metav1.ListOptions{}
+	if cspcName != "" {
+		listOpts.LabelSelector = types.CStorPoolClusterLabelKey + "=" + cspcName
+	}
+	cspiList, err := wh.clientset.CstorV1().CStorPoolInstances(namespace).List(context.TODO(), listOpts)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list cspi")
+	}
+	for _, cspi := range cspiList.Items {
+		if cspi.DeletionTimestamp != nil {
+			continue
+		}
+		rgs := append(cspi.Spec.DataRaidGroups, cspi.Spec.WriteCacheRaidGroups...)
+		for _, bd := range getBDsFromRaidGroups(rgs) {
+			if bd == bdName {
+				return cspi.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	openebsapis "github.com/openebs/api/v3/pkg/apis/openebs.io/v1alpha1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	v1 "k8s.io/api/admission/v1"
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func fakeBD(name, claimRef string, claimState openebsapis.DeviceClaimState) *openebsapis.BlockDevice {
+	bd := &openebsapis.BlockDevice{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "openebs"},
+		Status:     openebsapis.DeviceStatus{ClaimState: claimState},
+	}
+	if claimRef != "" {
+		bd.Spec.ClaimRef = &corev1.ObjectReference{Name: claimRef}
+	}
+	return bd
+}
+
+func fakeBDC(name, bdName, cspcName string, finalizers ...string) *openebsapis.BlockDeviceClaim {
+	return &openebsapis.BlockDeviceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "openebs",
+			Labels:     map[string]string{types.CStorPoolClusterLabelKey: cspcName},
+			Finalizers: finalizers,
+		},
+		Spec: openebsapis.DeviceClaimSpec{BlockDeviceName: bdName},
+	}
+}
+
+func TestValidateBlockDevice(t *testing.T) {
+	cspi := &cstor.CStorPoolInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cspc-1-abcd",
+			Namespace: "openebs",
+			Labels:    map[string]string{types.CStorPoolClusterLabelKey: "cspc-1"},
+		},
+		Spec: cstor.CStorPoolInstanceSpec{
+			DataRaidGroups: []cstor.RaidGroup{
+				{
+					CStorPoolInstanceBlockDevices: []cstor.CStorPoolInstanceBlockDevice{
+						{BlockDeviceName: "bd-1"},
+					},
+				},
+			},
+			WriteCacheRaidGroups: []cstor.RaidGroup{
+				{
+					CStorPoolInstanceBlockDevices: []cstor.CStorPoolInstanceBlockDevice{
+						{BlockDeviceName: "bd-2"},
+					},
+				},
+			},
+		},
+	}
+	deletionTime := metav1.Now()
+	deletingCSPI := &cstor.CStorPoolInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "cspc-2-abcd",
+			Namespace:         "openebs",
+			Labels:            map[string]string{types.CStorPoolClusterLabelKey: "cspc-2"},
+			DeletionTimestamp: &deletionTime,
+			Finalizers:        []string{types.CSPCFinalizer},
+		},
+		Spec: cstor.CStorPoolInstanceSpec{
+			DataRaidGroups: []cstor.RaidGroup{
+				{
+					CStorPoolInstanceBlockDevices: []cstor.CStorPoolInstanceBlockDevice{
+						{BlockDeviceName: "bd-4"},
+					},
+				},
+			},
+		},
+	}
+	bdc1 := fakeBDC("bdc-1", "bd-1", "cspc-1", types.CSPCFinalizer)
+	bdc3 := fakeBDC("bdc-3", "bd-3", "cspc-1", types.CSPCFinalizer)
+	bdc4 := fakeBDC("bdc-4", "bd-4", "cspc-2", types.CSPCFinalizer)
+
+	relabeledBDC1 := fakeBDC("bdc-1", "bd-1", "cspc-3", types.CSPCFinalizer)
+	unfinalizedBDC1 := fakeBDC("bdc-1", "bd-1", "cspc-1")
+	annotatedBDC1 := fakeBDC("bdc-1", "bd-1", "cspc-1", types.CSPCFinalizer)
+	annotatedBDC1.Annotations = map[string]string{"foo": "bar"}
+	deletingBDC1 := fakeBDC("bdc-1", "bd-1", "cspc-1")
+	deletingBDC1.DeletionTimestamp = &deletionTime
+
+	tests := map[string]struct {
+		kind        string
+		operation   v1.Operation
+		oldObj      runtime.Object
+		newObj      runtime.Object
+		expectedRsp bool
+	}{
+		"delete bd used by data raid group": {
+			kind:        "BlockDevice",
+			operation:   v1.Delete,
+			oldObj:      fakeBD("bd-1", "bdc-1", openebsapis.BlockDeviceClaimed),
+			expectedRsp: false,
+		},
+		"delete bd used by write cache raid group without bdc": {
+			kind:        "BlockDevice",
+			operation:   v1.Delete,
+			oldObj:      fakeBD("bd-2", "", openebsapis.BlockDeviceUnclaimed),
+			expectedRsp: false,
+		},
+		"delete bd claimed but not used by pool": {
+			kind:        "BlockDevice",
+			operation:   v1.Delete,
+			oldObj:      fakeBD("bd-3", "bdc-3", openebsapis.BlockDeviceClaimed),
+			expectedRsp: true,
+		},
+		"delete bd used by pool under deletion": {
+			kind:        "BlockDevice",
+			operation:   v1.Delete,
+			oldObj:      fakeBD("bd-4", "bdc-4", openebsapis.BlockDeviceClaimed),
+			expectedRsp: true,
+		},
+		"release bd used by pool": {
+			kind:        "BlockDevice",
+			operation:   v1.Update,
+			oldObj:      fakeBD("bd-1", "bdc-1", openebsapis.BlockDeviceClaimed),
+			newObj:      fakeBD("bd-1", "", openebsapis.BlockDeviceReleased),
+			expectedRsp: false,
+		},
+		"rebind bd used by pool": {
+			kind:        "BlockDevice",
+			operation:   v1.Update,
+			oldObj:      fakeBD("bd-1", "bdc-1", openebsapis.BlockDeviceClaimed),
+			newObj:      fakeBD("bd-1", "bdc-other", openebsapis.BlockDeviceClaimed),
+			expectedRsp: false,
+		},
+		"update bd used by pool without releasing it": {
+			kind:        "BlockDevice",
+			operation:   v1.Update,
+			oldObj:      fakeBD("bd-1", "bdc-1", openebsapis.BlockDeviceClaimed),
+			newObj:      fakeBD("bd-1", "bdc-1", openebsapis.BlockDeviceClaimed),
+			expectedRsp: true,
+		},
+		"delete bdc used by pool": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Delete,
+			oldObj:      bdc1,
+			expectedRsp: false,
+		},
+		"delete bdc not used by pool": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Delete,
+			oldObj:      bdc3,
+			expectedRsp: true,
+		},
+		"delete bdc used by pool under deletion": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Delete,
+			oldObj:      bdc4,
+			expectedRsp: true,
+		},
+		"change cspc label of bdc used by pool": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Update,
+			oldObj:      bdc1,
+			newObj:      relabeledBDC1,
+			expectedRsp: false,
+		},
+		"remove cspc finalizer of bdc used by pool": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Update,
+			oldObj:      bdc1,
+			newObj:      unfinalizedBDC1,
+			expectedRsp: false,
+		},
+		"annotate bdc used by pool": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Update,
+			oldObj:      bdc1,
+			newObj:      annotatedBDC1,
+			expectedRsp: true,
+		},
+		"update bdc under deletion": {
+			kind:        "BlockDeviceClaim",
+			operation:   v1.Update,
+			oldObj:      bdc1,
+			newObj:      deletingBDC1,
+			expectedRsp: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().withOpenebsObjects(cspi, deletingCSPI, bdc1, bdc3, bdc4)
+			req := &v1.AdmissionRequest{
+				Operation: test.operation,
+				Kind:      metav1.GroupVersionKind{Kind: test.kind},
+				OldObject: runtime.RawExtension{Raw: serialize(test.oldObj)},
+			}
+			if test.newObj != nil {
+				req.Object = runtime.RawExtension{Raw: serialize(test.newObj)}
+			}
+			resp := f.wh.validate(&v1.AdmissionReview{Request: req})
+			if resp.Allowed != test.expectedRsp {
+				t.Errorf("%s test case failed expected response: %t but got %t error: %v",
+					name, test.expectedRsp, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
+
+func TestAddBDWebhookIfMissing(t *testing.T) {
+	clientConfig := admissionregistration.WebhookClientConfig{CABundle: []byte("ca")}
+	config := &admissionregistration.ValidatingWebhookConfiguration{
+		Webhooks: []admissionregistration.ValidatingWebhook{{
+			Name: webhookHandlerName,
+			Rules: []admissionregistration.RuleWithOperations{
+				cvpRuleWithOperations,
+				bdRuleWithOperations,
+			},
+			ClientConfig: clientConfig,
+		}},
+	}
+	// transformation is applied twice to verify it is idempotent
+	addBDWebhookIfMissing(config)
+	addBDWebhookIfMissing(config)
+
+	if len(config.Webhooks) != 2 {
+		t.Fatalf("expected 2 webhooks but got %d", len(config.Webhooks))
+	}
+	if len(config.Webhooks[0].Rules) != 1 {
+		t.Errorf("expected block device rule removed from %s but got %v",
+			webhookHandlerName, config.Webhooks[0].Rules)
+	}
+	bdWebhook := config.Webhooks[1]
+	if bdWebhook.Name != bdWebhookHandlerName || *bdWebhook.FailurePolicy != Ignore {
+		t.Errorf("expected %s with failure policy %s but got %s with %s",
+			bdWebhookHandlerName, Ignore, bdWebhook.Name, *bdWebhook.FailurePolicy)
+	}
+	if string(bdWebhook.ClientConfig.CABundle) != "ca" {
+		t.Errorf("expected CA bundle of %s copied but got %q",
+			webhookHandlerName, bdWebhook.ClientConfig.CABundle)
+	}
+}
@@ -0,0 +1,440 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// CertSecretEnvVar is the constant for env variable
+	// ADMISSION_WEBHOOK_CERT_SECRET which is the name of an externally
+	// managed secret (e.g. issued by cert-manager) holding the serving
+	// certificate. The secret must be of type kubernetes.io/tls and the
+	// webhook only reloads it, renewal is left to the issuer.
+	CertSecretEnvVar = "ADMISSION_WEBHOOK_CERT_SECRET"
+
+	// certRotationThreshold is the remaining validity of the serving
+	// certificate below which the self-signed certificates are rotated
+	certRotationThreshold = 30 * 24 * time.Hour
+	// certCheckInterval is the interval at which the certificate secret
+	// is checked for expiry and external renewals
+	certCheckInterval = time.Minute
+	// secretPollInterval is the interval at which the webhook replicas wait
+	// for the certificate secret to be created by the leader
+	secretPollInterval = 2 * time.Second
+	// secretWaitTimeout is the time after which the replica gives up on
+	// waiting for the certificate secret
+	secretWaitTimeout = 5 * time.Minute
+	// maxCABundleCerts is the number of CA certificates kept in the
+	// caBundle. Previous CAs are kept along with the current one so that
+	// the apiserver trusts the webhook pods still serving the old
+	// certificate while rotation is in progress, even when more than one
+	// replica attempted the rotation.
+	maxCABundleCerts = 3
+)
+
+// certSource describes the secret holding the webhook certificates and the
+// keys under which the certificates are stored
+type certSource struct {
+	secretName string
+	certKey    string
+	keyKey     string
+	caKey      string
+	// external is true if certificates are issued by someone else and the
+	// webhook must not rotate them
+	external bool
+}
+
+// getCertSource returns the secret from which the webhook serving
+// certificate is loaded
+func getCertSource() certSource {
+	secretName := strings.TrimSpace(os.Getenv(CertSecretEnvVar))
+	if secretName == "" {
+		return certSource{
+			secretName: validatorSecret,
+			certKey:    appCrt,
+			keyKey:     appKey,
+			caKey:      rootCrt,
+		}
+	}
+	return certSource{
+		secretName: secretName,
+		certKey:    corev1.TLSCertKey,
+		keyKey:     corev1.TLSPrivateKeyKey,
+		caKey:      rootCrt,
+		external:   true,
+	}
+}
+
+// newCertKeyPairs creates a self-signed CA and a server certificate signed
+// by it for the webhook service
+func newCertKeyPairs(serviceName, namespace string) (*KeyPair, *KeyPair, error) {
+	// Create a signing certificate
+	caKeyPair, err := NewCA(fmt.Sprintf("%s-ca", serviceName))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create root-ca: %v", err)
+	}
+
+	// Create app certs signed through the certificate created above
+	apiServerKeyPair, err := NewServerKeyPair(
+		caKeyPair,
+		strings.Join([]string{serviceName, namespace, "svc"}, "."),
+		serviceName,
+		namespace,
+		"cluster.local",
+		[]string{},
+		[]string{},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create server key pair: %v", err)
+	}
+	return caKeyPair, apiServerKeyPair, nil
+}
+
+// mergeCABundle returns the caBundle having the given CA followed by the
+// certificates of the current bundle which are still valid
+func mergeCABundle(caBytes, currentBundle []byte, now time.Time) []byte {
+	bundle := append([]byte{}, caBytes...)
+	if len(currentBundle) == 0 || bytes.Equal(caBytes, currentBundle) {
+		return bundle
+	}
+	newCerts, err := certutil.ParseCertsPEM(caBytes)
+	if err != nil {
+		return bundle
+	}
+	// bundle might not be parsable if it was edited manually, in that case
+	// the new CA alone is trusted
+	oldCerts, err := certutil.ParseCertsPEM(currentBundle)
+	if err != nil {
+		return bundle
+	}
+	count := len(newCerts)
+	for _, cert := range oldCerts {
+		if count >= maxCABundleCerts {
+			break
+		}
+		if now.After(cert.NotAfter) || containsCert(newCerts, cert) {
+			continue
+		}
+		bundle = append(bundle, EncodeCertPEM(cert)...)
+		count++
+	}
+	return bundle
+}
+
+func containsCert(certs []*x509.Certificate, cert *x509.Certificate) bool {
+	for _, c := range certs {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+	return false
+}
+
+// setCABundle makes the client config trust the given CA, it returns true
+// if the caBundle is changed
+func setCABundle(clientConfig *admissionregistration.WebhookClientConfig, caBytes []byte) bool {
+	if bytes.HasPrefix(clientConfig.CABundle, caBytes) {
+		return false
+	}
+	clientConfig.CABundle = mergeCABundle(caBytes, clientConfig.CABundle, time.Now())
+	return true
+}
+
+// updateCABundle makes the webhook configurations trust the given CA. All
+// the webhooks of a configuration are updated in a single update call so
+// that apiserver never sees a partially updated configuration.
+func (c *client) updateCABundle(caBytes []byte) error {
+	if len(caBytes) == 0 {
+		return nil
+	}
+	err := c.updateValidatorCABundle(caBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update caBundle of %s", validatorWebhook)
+	}
+	err = c.updateMutatorCABundle(caBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update caBundle of %s", mutatorWebhook)
+	}
+	return nil
+}
+
+func (c *client) updateValidatorCABundle(caBytes []byte) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		config, err := GetValidatorWebhook(validatorWebhook, c.kubeClient)
+		if k8serror.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		changed := false
+		for i := range config.Webhooks {
+			changed = setCABundle(&config.Webhooks[i].ClientConfig, caBytes) || changed
+		}
+		if !changed {
+			return nil
+		}
+		_, err = c.kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().
+			Update(context.TODO(), config, metav1.UpdateOptions{})
+		if err == nil {
+			klog.Infof("Updated caBundle of webhook configuration %s", validatorWebhook)
+		}
+		return err
+	})
+}
+
+func (c *client) updateMutatorCABundle(caBytes []byte) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		config, err := GetMutatorWebhook(mutatorWebhook, c.kubeClient)
+		if k8serror.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		changed := false
+		for i := range config.Webhooks {
+			changed = setCABundle(&config.Webhooks[i].ClientConfig, caBytes) || changed
+		}
+		if !changed {
+			return nil
+		}
+		_, err = c.kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().
+			Update(context.TODO(), config, metav1.UpdateOptions{})
+		if err == nil {
+			klog.Infof("Updated caBundle of webhook configuration %s", mutatorWebhook)
+		}
+		return err
+	})
+}
+
+// certWatcher serves the webhook certificate from the certificate secret and
+// reloads it whenever the secret changes. Self-signed certificates are
+// rotated before they expire.
+type certWatcher struct {
+	client
+	namespace string
+	source    certSource
+	// now is used to compute the expiry of certificates, overridden in tests
+	now func() time.Time
+	// isLeader returns true if this replica manages the certificates, other
+	// replicas only reload the certificates rotated by the leader
+	isLeader func() bool
+
+	lock sync.RWMutex
+	// cert is the certificate served by the webhook
+	cert *tls.Certificate
+	// certBytes is the PEM encoded certificate from which cert is loaded
+	certBytes []byte
+}
+
+// newCertWatcher returns a certWatcher which has loaded the current
+// certificate from the secret
+func newCertWatcher(kubeClient kubernetes.Interface, namespace string) (*certWatcher, error) {
+	cw := &certWatcher{
+		client:    client{kubeClient: kubeClient},
+		namespace: namespace,
+		source:    getCertSource(),
+		now:       time.Now,
+		isLeader:  func() bool { return true },
+	}
+	// secret is created by the replica managing the webhook configuration
+	// which might not have bootstrapped it yet
+	var secret *corev1.Secret
+	err := wait.PollImmediate(secretPollInterval, secretWaitTimeout, func() (bool, error) {
+		var getErr error
+		secret, getErr = GetSecret(namespace, cw.source.secretName, kubeClient)
+		if k8serror.IsNotFound(getErr) {
+			klog.Infof("Waiting for secret %s to be created", cw.source.secretName)
+			return false, nil
+		}
+		return getErr == nil, getErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read secret(%s) object %v",
+			cw.source.secretName,
+			err,
+		)
+	}
+	err = cw.load(secret)
+	if err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// GetCertificate returns the certificate to be served for the TLS handshake
+func (cw *certWatcher) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cw.lock.RLock()
+	defer cw.lock.RUnlock()
+	return cw.cert, nil
+}
+
+// load loads the serving certificate from the secret if it has changed
+func (cw *certWatcher) load(secret *corev1.Secret) error {
+	certBytes, ok := secret.Data[cw.source.certKey]
+	if !ok {
+		return fmt.Errorf(
+			"%s value not found in %s secret",
+			cw.source.certKey,
+			secret.Name,
+		)
+	}
+	keyBytes, ok := secret.Data[cw.source.keyKey]
+	if !ok {
+		return fmt.Errorf(
+			"%s value not found in %s secret",
+			cw.source.keyKey,
+			secret.Name,
+		)
+	}
+
+	cw.lock.RLock()
+	unchanged := bytes.Equal(cw.certBytes, certBytes)
+	cw.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	sCert, err := tls.X509KeyPair(certBytes, keyBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load certificate from %s secret", secret.Name)
+	}
+	sCert.Leaf, err = x509.ParseCertificate(sCert.Certificate[0])
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse certificate from %s secret", secret.Name)
+	}
+
+	cw.lock.Lock()
+	cw.cert = &sCert
+	cw.certBytes = certBytes
+	cw.lock.Unlock()
+	klog.Infof("Loaded webhook certificate from %s secret, valid till %s",
+		secret.Name, sCert.Leaf.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// needsRotation returns true if the self-signed serving certificate is
+// about to expire
+func (cw *certWatcher) needsRotation() bool {
+	if cw.source.external {
+		return false
+	}
+	cw.lock.RLock()
+	defer cw.lock.RUnlock()
+	return cw.cert.Leaf.NotAfter.Sub(cw.now()) < certRotationThreshold
+}
+
+// Run checks the certificate secret every certCheckInterval until stopCh is
+// closed
+func (cw *certWatcher) Run(stopCh <-chan struct{}) {
+	klog.Infof("Starting certificate watcher for %s secret", cw.source.secretName)
+	wait.Until(func() {
+		err := cw.sync()
+		if err != nil {
+			klog.Errorf("failed to sync webhook certificate: %v", err)
+		}
+	}, certCheckInterval, stopCh)
+}
+
+// sync reloads the certificate from the secret, rotates it if required and
+// makes sure that the webhook configuration trusts the current CA
+func (cw *certWatcher) sync() error {
+	secret, err := GetSecret(cw.namespace, cw.source.secretName, cw.kubeClient)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get secret %s", cw.source.secretName)
+	}
+	err = cw.load(secret)
+	if err != nil {
+		return err
+	}
+	if !cw.isLeader() {
+		return nil
+	}
+	if cw.needsRotation() {
+		secret, err = cw.rotate(secret)
+		if err != nil {
+			return err
+		}
+		err = cw.load(secret)
+		if err != nil {
+			return err
+		}
+	}
+	// webhook configurations might have been recreated or another replica
+	// might have rotated the certificates
+	return cw.updateCABundle(secret.Data[cw.source.caKey])
+}
+
+// rotate replaces the self-signed certificates in the secret. The new CA is
+// added to the caBundle before the secret is updated so that apiserver
+// trusts the webhook as soon as it starts serving the new certificate. The
+// secret update fails with conflict if another webhook replica rotated the
+// certificates first, in that case the latest secret is returned.
+func (cw *certWatcher) rotate(secret *corev1.Secret) (*corev1.Secret, error) {
+	klog.Infof("Rotating webhook certificates of %s secret", secret.Name)
+	caKeyPair, serverKeyPair, err := newCertKeyPairs(validatorServiceName, cw.namespace)
+	if err != nil {
+		return nil, err
+	}
+	caBytes := EncodeCertPEM(caKeyPair.Cert)
+	err = cw.updateCABundle(caBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	newSecret := secret.DeepCopy()
+	newSecret.Data = map[string][]byte{
+		appCrt:  EncodeCertPEM(serverKeyPair.Cert),
+		appKey:  EncodePrivateKeyPEM(serverKeyPair.Key),
+		rootCrt: caBytes,
+	}
+	updated, err := cw.kubeClient.CoreV1().Secrets(cw.namespace).
+		Update(context.TODO(), newSecret, metav1.UpdateOptions{})
+	if k8serror.IsConflict(err) {
+		klog.Infof("Secret %s was updated concurrently, using the latest certificates", secret.Name)
+		return GetSecret(cw.namespace, secret.Name, cw.kubeClient)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update secret %s", secret.Name)
+	}
+	klog.Infof("Rotated webhook certificates of %s secret, valid till %s",
+		secret.Name, serverKeyPair.Cert.NotAfter.Format(time.RFC3339))
+	return updated, nil
+}
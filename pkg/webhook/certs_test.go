@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+const testNamespace = "openebs"
+
+func newTestCertWatcher(t *testing.T) *certWatcher {
+	kubeClient := fake.NewSimpleClientset()
+	c := &client{kubeClient: kubeClient}
+	secret, err := c.createCertsSecret(metav1.OwnerReference{},
+		validatorSecret, validatorServiceName, testNamespace)
+	if err != nil {
+		t.Fatalf("failed to create cert secret: %v", err)
+	}
+	_, err = kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().
+		Create(context.TODO(), &admissionregistration.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: validatorWebhook},
+			Webhooks: []admissionregistration.ValidatingWebhook{
+				{
+					Name: webhookHandlerName,
+					ClientConfig: admissionregistration.WebhookClientConfig{
+						CABundle: secret.Data[rootCrt],
+					},
+				},
+			},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("failed to create webhook config: %v", err)
+	}
+	cw, err := newCertWatcher(kubeClient, testNamespace)
+	if err != nil {
+		t.Fatalf("failed to create cert watcher: %v", err)
+	}
+	return cw
+}
+
+func TestCertWatcherRotation(t *testing.T) {
+	cw := newTestCertWatcher(t)
+	oldCert, _ := cw.GetCertificate(nil)
+	oldSecret, _ := GetSecret(testNamespace, validatorSecret, cw.kubeClient)
+
+	// certificate is far from expiry, nothing should change
+	if err := cw.sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cert, _ := cw.GetCertificate(nil); cert != oldCert {
+		t.Fatalf("certificate rotated before reaching the threshold")
+	}
+
+	cw.now = func() time.Time {
+		return oldCert.Leaf.NotAfter.Add(-certRotationThreshold / 2)
+	}
+	if err := cw.sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	newCert, _ := cw.GetCertificate(nil)
+	if newCert == oldCert || newCert.Leaf.Equal(oldCert.Leaf) {
+		t.Fatalf("certificate not reloaded after rotation")
+	}
+
+	newSecret, _ := GetSecret(testNamespace, validatorSecret, cw.kubeClient)
+	if bytes.Equal(newSecret.Data[rootCrt], oldSecret.Data[rootCrt]) {
+		t.Fatalf("CA not rotated in secret")
+	}
+	config, _ := GetValidatorWebhook(validatorWebhook, cw.kubeClient)
+	caBundle := config.Webhooks[0].ClientConfig.CABundle
+	if !bytes.HasPrefix(caBundle, newSecret.Data[rootCrt]) ||
+		!bytes.Contains(caBundle, oldSecret.Data[rootCrt]) {
+		t.Fatalf("caBundle should trust both new and old CA")
+	}
+}
+
+func TestCertWatcherFollower(t *testing.T) {
+	cw := newTestCertWatcher(t)
+	cw.isLeader = func() bool { return false }
+	oldCert, _ := cw.GetCertificate(nil)
+	oldSecret, _ := GetSecret(testNamespace, validatorSecret, cw.kubeClient)
+	cw.now = func() time.Time {
+		return oldCert.Leaf.NotAfter.Add(-certRotationThreshold / 2)
+	}
+	if err := cw.sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	newSecret, _ := GetSecret(testNamespace, validatorSecret, cw.kubeClient)
+	if !bytes.Equal(newSecret.Data[rootCrt], oldSecret.Data[rootCrt]) {
+		t.Fatalf("certificates rotated by replica not managing the webhook configuration")
+	}
+
+	// certificates rotated by the leader are reloaded
+	c := &client{kubeClient: cw.kubeClient}
+	_ = cw.kubeClient.CoreV1().Secrets(testNamespace).Delete(context.TODO(), validatorSecret, metav1.DeleteOptions{})
+	if _, err := c.createCertsSecret(metav1.OwnerReference{},
+		validatorSecret, validatorServiceName, testNamespace); err != nil {
+		t.Fatalf("failed to create cert secret: %v", err)
+	}
+	if err := cw.sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cert, _ := cw.GetCertificate(nil); cert == oldCert {
+		t.Fatalf("certificate rotated by the leader not reloaded")
+	}
+}
+
+func TestCertWatcherExternalSecret(t *testing.T) {
+	cw := newTestCertWatcher(t)
+	cert, _ := cw.GetCertificate(nil)
+	cw.source.external = true
+	cw.now = func() time.Time { return cert.Leaf.NotAfter }
+	if cw.needsRotation() {
+		t.Fatalf("externally issued certificate must not be rotated")
+	}
+}
+
+func TestMergeCABundle(t *testing.T) {
+	ca1, _ := NewCA("ca-1")
+	ca2, _ := NewCA("ca-2")
+	ca3, _ := NewCA("ca-3")
+	ca4, _ := NewCA("ca-4")
+	pem := func(kps ...*KeyPair) []byte {
+		var b []byte
+		for _, kp := range kps {
+			b = append(b, EncodeCertPEM(kp.Cert)...)
+		}
+		return b
+	}
+	now := time.Now()
+
+	tests := map[string]struct {
+		ca, bundle []byte
+		now        time.Time
+		want       []byte
+	}{
+		"empty bundle": {
+			ca:   pem(ca1),
+			now:  now,
+			want: pem(ca1),
+		},
+		"previous CA is kept": {
+			ca:     pem(ca2),
+			bundle: pem(ca1),
+			now:    now,
+			want:   pem(ca2, ca1),
+		},
+		"oldest CA is dropped": {
+			ca:     pem(ca4),
+			bundle: pem(ca3, ca2, ca1),
+			now:    now,
+			want:   pem(ca4, ca3, ca2),
+		},
+		"CA already in bundle is not repeated": {
+			ca:     pem(ca2),
+			bundle: pem(ca1, ca2),
+			now:    now,
+			want:   pem(ca2, ca1),
+		},
+		"expired CA is dropped": {
+			ca:     pem(ca2),
+			bundle: pem(ca1),
+			now:    ca1.Cert.NotAfter.Add(time.Hour),
+			want:   pem(ca2),
+		},
+		"invalid bundle is replaced": {
+			ca:     pem(ca1),
+			bundle: []byte("invalid"),
+			now:    now,
+			want:   pem(ca1),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := mergeCABundle(test.ca, test.bundle, test.now)
+			if !bytes.Equal(got, test.want) {
+				gotCerts, _ := certutil.ParseCertsPEM(got)
+				wantCerts, _ := certutil.ParseCertsPEM(test.want)
+				t.Errorf("got %d certificates in bundle, want %d in order", len(gotCerts), len(wantCerts))
+			}
+		})
+	}
+}
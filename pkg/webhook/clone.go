@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// CloneAcrossCSPCKey is the annotation on clone PVC to allow the clone
+	// to be provisioned on a different CSPC than the source volume. Replicas
+	// of such clones are seeded from a source replica, hence it is opt-in.
+	CloneAcrossCSPCKey = "cstor.openebs.io/clone-across-cspc"
+
+	// cstorCSIProvisioner is the name of cstor CSI driver
+	cstorCSIProvisioner = "cstor.csi.openebs.io"
+	// cspcParameterKey is the storage class parameter holding the CSPC name
+	cspcParameterKey = "cstorPoolCluster"
+	// pvLabelKey is the label on volume replicas holding the volume name
+	pvLabelKey = "openebs.io/persistent-volume"
+	// snapshotGroup is the API group of CSI snapshot resources
+	snapshotGroup = "snapshot.storage.k8s.io"
+)
+
+var (
+	volumeSnapshotResource = schema.GroupVersionResource{
+		Group:    snapshotGroup,
+		Version:  "v1",
+		Resource: "volumesnapshots",
+	}
+	volumeSnapshotContentResource = schema.GroupVersionResource{
+		Group:    snapshotGroup,
+		Version:  "v1",
+		Resource: "volumesnapshotcontents",
+	}
+)
+
+// isSnapshotDataSource returns true if the PVC is requested to be created
+// from a CSI VolumeSnapshot
+func isSnapshotDataSource(dataSource *corev1.TypedLocalObjectReference) bool {
+	return dataSource != nil &&
+		dataSource.Kind == "VolumeSnapshot" &&
+		dataSource.APIGroup != nil && *dataSource.APIGroup == snapshotGroup
+}
+
+// cloneSource holds the details of the snapshot from which the clone PVC is
+// requested
+type cloneSource struct {
+	snapshotName string
+	ready        bool
+	restoreSize  *resource.Quantity
+	// volumeName is the name of cstor volume of the snapshot
+	volumeName string
+	// cstorSnapName is the name of snapshot on cstor volume
+	cstorSnapName string
+}
+
+// validateCloneRequest validates the clone PVC of cstor volume against its
+// source snapshot. PVCs of other provisioners are not validated.
+func (wh *webhook) validateCloneRequest(pvc *corev1.PersistentVolumeClaim) error {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return nil
+	}
+	sc, err := wh.kubeClient.StorageV1().StorageClasses().
+		Get(context.TODO(), *pvc.Spec.StorageClassName, metav1.GetOptions{})
+	if err != nil {
+		if k8serror.IsNotFound(err) {
+			// provisioning waits for the storage class
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get storageclass %s", *pvc.Spec.StorageClassName)
+	}
+	if sc.Provisioner != cstorCSIProvisioner {
+		return nil
+	}
+
+	src, err := wh.getCloneSource(pvc.Namespace, pvc.Spec.DataSource.Name)
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		// provisioning waits for the snapshot to be created
+		klog.Infof("Skipping validation of clone pvc %s/%s: snapshot %s not found",
+			pvc.Namespace, pvc.Name, pvc.Spec.DataSource.Name)
+		return nil
+	}
+	if !src.ready {
+		return errors.Errorf("source snapshot %s is not ready to use", src.snapshotName)
+	}
+
+	openebsNamespace, err := getOpenebsNamespace()
+	if err != nil {
+		return err
+	}
+	srcCVC, err := wh.clientset.CstorV1().CStorVolumeConfigs(openebsNamespace).
+		Get(context.TODO(), src.volumeName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source volume %s of snapshot %s",
+			src.volumeName, src.snapshotName)
+	}
+
+	err = validateCloneCapacity(pvc, srcCVC, src)
+	if err != nil {
+		return err
+	}
+
+	srcCSPC := srcCVC.Labels[string(types.CStorPoolClusterLabelKey)]
+	if sc.Parameters[cspcParameterKey] != srcCSPC &&
+		pvc.Annotations[CloneAcrossCSPCKey] != "true" {
+		return errors.Errorf(
+			"storageclass %s points to cspc %q but source volume %s is on cspc %q, "+
+				"set annotation %s: \"true\" on pvc to clone across cspc",
+			sc.Name, sc.Parameters[cspcParameterKey], src.volumeName, srcCSPC, CloneAcrossCSPCKey)
+	}
+
+	return wh.validateSnapshotReplicas(openebsNamespace, src)
+}
+
+// validateCloneCapacity rejects the clone PVC requesting less capacity than
+// the source snapshot
+func validateCloneCapacity(pvc *corev1.PersistentVolumeClaim, srcCVC *cstor.CStorVolumeConfig, src *cloneSource) error {
+	srcSize := src.restoreSize
+	if srcSize == nil || srcSize.IsZero() {
+		capacity := srcCVC.Spec.Capacity[corev1.ResourceStorage]
+		srcSize = &capacity
+	}
+	pvcSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if pvcSize.Cmp(*srcSize) < 0 {
+		return errors.Errorf(
+			"requested size %s is smaller than size %s of source snapshot %s",
+			pvcSize.String(), srcSize.String(), src.snapshotName)
+	}
+	return nil
+}
+
+// validateSnapshotReplicas validates that the cstor snapshot exists on the
+// quorum of healthy source replicas, which is the requirement for the
+// snapshot to have been taken successfully
+func (wh *webhook) validateSnapshotReplicas(namespace string, src *cloneSource) error {
+	cv, err := wh.clientset.CstorV1().CStorVolumes(namespace).
+		Get(context.TODO(), src.volumeName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source cstorvolume %s", src.volumeName)
+	}
+	cvrList, err := wh.clientset.CstorV1().CStorVolumeReplicas(namespace).
+		List(context.TODO(), metav1.ListOptions{
+			LabelSelector: pvLabelKey + "=" + src.volumeName,
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list replicas of source volume %s", src.volumeName)
+	}
+
+	readyCount := 0
+	for _, cvr := range cvrList.Items {
+		if cvr.Status.Phase != cstor.CVRStatusOnline {
+			continue
+		}
+		if _, ok := cvr.Status.Snapshots[src.cstorSnapName]; ok {
+			readyCount++
+		}
+	}
+	required := cv.Spec.ReplicationFactor/2 + 1
+	if readyCount < required {
+		return errors.Errorf(
+			"source snapshot %s is available on %d healthy replica(s) of volume %s, requires %d",
+			src.snapshotName, readyCount, src.volumeName, required)
+	}
+	return nil
+}
+
+// getCloneSource returns the details of the snapshot and its content, nil is
+// returned if the snapshot doesn't exist
+func (wh *webhook) getCloneSource(namespace, snapshotName string) (*cloneSource, error) {
+	snap, err := wh.dynamicClient.Resource(volumeSnapshotResource).Namespace(namespace).
+		Get(context.TODO(), snapshotName, metav1.GetOptions{})
+	if err != nil {
+		if k8serror.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get volumesnapshot %s/%s", namespace, snapshotName)
+	}
+
+	src := &cloneSource{snapshotName: snapshotName}
+	src.ready, _, _ = unstructured.NestedBool(snap.Object, "status", "readyToUse")
+	if size, found, _ := unstructured.NestedString(snap.Object, "status", "restoreSize"); found {
+		quantity, err := resource.ParseQuantity(size)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid restore size of volumesnapshot %s", snapshotName)
+		}
+		src.restoreSize = &quantity
+	}
+	if !src.ready {
+		return src, nil
+	}
+
+	contentName, _, _ := unstructured.NestedString(snap.Object, "status", "boundVolumeSnapshotContentName")
+	if contentName == "" {
+		return nil, errors.Errorf("volumesnapshot %s is not bound to a snapshot content", snapshotName)
+	}
+	content, err := wh.dynamicClient.Resource(volumeSnapshotContentResource).
+		Get(context.TODO(), contentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get volumesnapshotcontent %s", contentName)
+	}
+	// cstor CSI driver sets the snapshot handle as <volume>@<snapshot>
+	handle, _, _ := unstructured.NestedString(content.Object, "status", "snapshotHandle")
+	parts := strings.Split(handle, "@")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("invalid snapshot handle %q of volumesnapshotcontent %s", handle, contentName)
+	}
+	src.volumeName = parts[0]
+	src.cstorSnapName = parts[1]
+	return src, nil
+}
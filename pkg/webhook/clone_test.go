@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"os"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func fakeVolumeSnapshot(name string, ready bool, restoreSize string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "snapshot.storage.k8s.io/v1",
+			"kind":       "VolumeSnapshot",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"readyToUse":                     ready,
+				"restoreSize":                    restoreSize,
+				"boundVolumeSnapshotContentName": "snapcontent-" + name,
+			},
+		},
+	}
+}
+
+func fakeVolumeSnapshotContent(name, handle string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "snapshot.storage.k8s.io/v1",
+			"kind":       "VolumeSnapshotContent",
+			"metadata": map[string]interface{}{
+				"name": "snapcontent-" + name,
+			},
+			"status": map[string]interface{}{
+				"snapshotHandle": handle,
+			},
+		},
+	}
+}
+
+func fakeSourceCVR(name string, phase cstor.CStorVolumeReplicaPhase, snapshots ...string) *cstor.CStorVolumeReplica {
+	cvr := &cstor.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openebs",
+			Labels:    map[string]string{pvLabelKey: "pvc-src"},
+		},
+		Status: cstor.CStorVolumeReplicaStatus{
+			Phase:     phase,
+			Snapshots: map[string]cstor.CStorSnapshotInfo{},
+		},
+	}
+	for _, snap := range snapshots {
+		cvr.Status.Snapshots[snap] = cstor.CStorSnapshotInfo{}
+	}
+	return cvr
+}
+
+func TestValidatePVCCloneRequest(t *testing.T) {
+	os.Setenv("OPENEBS_NAMESPACE", "openebs")
+	snapshotGroupName := snapshotGroup
+	srcCVC := &cstor.CStorVolumeConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pvc-src",
+			Namespace: "openebs",
+			Labels:    map[string]string{string(types.CStorPoolClusterLabelKey): "cspc-1"},
+		},
+		Spec: cstor.CStorVolumeConfigSpec{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+		},
+	}
+	srcCV := &cstor.CStorVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-src", Namespace: "openebs"},
+		Spec:       cstor.CStorVolumeSpec{ReplicationFactor: 3},
+	}
+	storageClass := func(name, provisioner, cspc string) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: name},
+			Provisioner: provisioner,
+			Parameters:  map[string]string{cspcParameterKey: cspc},
+		}
+	}
+	kubeObjects := []runtime.Object{
+		storageClass("cstor-sc", cstorCSIProvisioner, "cspc-1"),
+		storageClass("cstor-sc-other", cstorCSIProvisioner, "cspc-2"),
+		storageClass("other-sc", "other.csi.io", ""),
+	}
+	snapshotObjects := []runtime.Object{
+		fakeVolumeSnapshot("snap-ready", true, "5Gi"),
+		fakeVolumeSnapshotContent("snap-ready", "pvc-src@snapshot-ready"),
+		fakeVolumeSnapshot("snap-not-ready", false, "5Gi"),
+		fakeVolumeSnapshot("snap-degraded", true, "5Gi"),
+		fakeVolumeSnapshotContent("snap-degraded", "pvc-src@snapshot-degraded"),
+	}
+	openebsObjects := []runtime.Object{
+		srcCVC, srcCV,
+		fakeSourceCVR("cvr-1", cstor.CVRStatusOnline, "snapshot-ready", "snapshot-degraded"),
+		fakeSourceCVR("cvr-2", cstor.CVRStatusOnline, "snapshot-ready"),
+		fakeSourceCVR("cvr-3", cstor.CVRStatusDegraded, "snapshot-ready", "snapshot-degraded"),
+	}
+
+	tests := map[string]struct {
+		storageClass string
+		snapshot     string
+		size         string
+		annotations  map[string]string
+		expectedRsp  bool
+	}{
+		"clone with valid snapshot": {
+			storageClass: "cstor-sc",
+			snapshot:     "snap-ready",
+			size:         "5Gi",
+			expectedRsp:  true,
+		},
+		"clone with larger size": {
+			storageClass: "cstor-sc",
+			snapshot:     "snap-ready",
+			size:         "10Gi",
+			expectedRsp:  true,
+		},
+		"clone with smaller size": {
+			storageClass: "cstor-sc",
+			snapshot:     "snap-ready",
+			size:         "1Gi",
+			expectedRsp:  false,
+		},
+		"clone on different cspc": {
+			storageClass: "cstor-sc-other",
+			snapshot:     "snap-ready",
+			size:         "5Gi",
+			expectedRsp:  false,
+		},
+		"clone on different cspc with opt-in annotation": {
+			storageClass: "cstor-sc-other",
+			snapshot:     "snap-ready",
+			size:         "5Gi",
+			annotations:  map[string]string{CloneAcrossCSPCKey: "true"},
+			expectedRsp:  true,
+		},
+		"clone from snapshot not ready to use": {
+			storageClass: "cstor-sc",
+			snapshot:     "snap-not-ready",
+			size:         "5Gi",
+			expectedRsp:  false,
+		},
+		"clone from snapshot missing on quorum of replicas": {
+			storageClass: "cstor-sc",
+			snapshot:     "snap-degraded",
+			size:         "5Gi",
+			expectedRsp:  false,
+		},
+		"clone from snapshot not yet created": {
+			storageClass: "cstor-sc",
+			snapshot:     "snap-missing",
+			size:         "5Gi",
+			expectedRsp:  true,
+		},
+		"clone of other provisioner": {
+			storageClass: "other-sc",
+			snapshot:     "snap-not-ready",
+			size:         "1Gi",
+			expectedRsp:  true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().withOpenebsObjects(openebsObjects...).withKubeObjects(kubeObjects...)
+			f.wh.dynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), snapshotObjects...)
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "clone",
+					Namespace:   "default",
+					Annotations: test.annotations,
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &test.storageClass,
+					DataSource: &corev1.TypedLocalObjectReference{
+						APIGroup: &snapshotGroupName,
+						Kind:     "VolumeSnapshot",
+						Name:     test.snapshot,
+					},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(test.size)},
+					},
+				},
+			}
+			req := &v1.AdmissionRequest{
+				Operation: v1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "PersistentVolumeClaim"},
+				Object:    runtime.RawExtension{Raw: serialize(pvc)},
+			}
+			resp := f.wh.validatePVCCreateRequest(req)
+			if resp.Allowed != test.expectedRsp {
+				t.Errorf("%s test case failed expected response: %t but got %t error: %v",
+					name, test.expectedRsp, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
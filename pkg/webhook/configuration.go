@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 
 	"github.com/openebs/api/v3/pkg/apis/types"
@@ -42,7 +43,14 @@ const (
 	validatorWebhook     = "openebs-cstor-validation-webhook"
 	validatorSecret      = "openebs-cstor-admission-secret"
 	webhookHandlerName   = "admission-webhook.cstor.openebs.io"
+	// bdWebhookHandlerName is the webhook validating the block devices and
+	// claims, it is separate as the failure policy of block device updates
+	// differs from the CStor resources
+	bdWebhookHandlerName = "bd-admission-webhook.cstor.openebs.io"
 	validationPath       = "/validate"
+	mutatorWebhook       = "openebs-cstor-mutation-webhook"
+	mutatorHandlerName   = "mutation-webhook.cstor.openebs.io"
+	mutationPath         = "/mutate"
 	validationPort       = 8443
 	webhookLabel         = "openebs.io/component-name" + "=" + "cstor-admission-webhook"
 	webhooksvcLabel      = "openebs.io/component-name" + "=" + "cstor-admission-webhook"
@@ -83,9 +91,13 @@ var (
 	transformSvc    = []transformSvcFunc{}
 	transformConfig = []transformConfigFunc{
 		addNSWithDeleteRule,
+		addRuleIfMissing(cvpRuleWithOperations),
+		addBDWebhookIfMissing,
+		addRuleIfMissing(cvcRuleWithOperations),
 	}
 	cvcRuleWithOperations = admissionregistration.RuleWithOperations{
 		Operations: []admissionregistration.OperationType{
+			admissionregistration.Create,
 			admissionregistration.Update,
 		},
 		Rule: admissionregistration.Rule{
@@ -94,6 +106,28 @@ var (
 			Resources:   []string{"cstorvolumeconfigs"},
 		},
 	}
+	cvpRuleWithOperations = admissionregistration.RuleWithOperations{
+		Operations: []admissionregistration.OperationType{
+			admissionregistration.Create,
+			admissionregistration.Update,
+		},
+		Rule: admissionregistration.Rule{
+			APIGroups:   []string{"cstor.openebs.io"},
+			APIVersions: []string{"v1"},
+			Resources:   []string{"cstorvolumepolicies"},
+		},
+	}
+	bdRuleWithOperations = admissionregistration.RuleWithOperations{
+		Operations: []admissionregistration.OperationType{
+			admissionregistration.Update,
+			admissionregistration.Delete,
+		},
+		Rule: admissionregistration.Rule{
+			APIGroups:   []string{"openebs.io"},
+			APIVersions: []string{"v1alpha1"},
+			Resources:   []string{"blockdevices", "blockdeviceclaims"},
+		},
+	}
 	nsRuleWithOperations = admissionregistration.RuleWithOperations{
 		Operations: []admissionregistration.OperationType{
 			admissionregistration.Delete,
@@ -217,6 +251,7 @@ func (c *client) createAdmissionValidatingConfig(
 			},
 			cvcRuleWithOperations,
 			nsRuleWithOperations,
+			cvpRuleWithOperations,
 		},
 		ClientConfig: admissionregistration.WebhookClientConfig{
 			Service: &admissionregistration.ServiceReference{
@@ -246,7 +281,10 @@ func (c *client) createAdmissionValidatingConfig(
 			},
 			OwnerReferences: []metav1.OwnerReference{ownerReference},
 		},
-		Webhooks: []admissionregistration.ValidatingWebhook{webhookHandler},
+		Webhooks: []admissionregistration.ValidatingWebhook{
+			webhookHandler,
+			getBDWebhook(webhookHandler.ClientConfig),
+		},
 	}
 
 	_, err = c.kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().
@@ -255,6 +293,85 @@ func (c *client) createAdmissionValidatingConfig(
 	return err
 }
 
+// createAdmissionMutatingConfig creates our MutatingWebhookConfiguration
+// resource if it does not exist.
+func (c *client) createAdmissionMutatingConfig(
+	ownerReference metav1.OwnerReference,
+	mutatorWebhook string,
+	namespace string,
+	serviceName string,
+	signingCert []byte,
+) error {
+
+	_, err := GetMutatorWebhook(mutatorWebhook, c.kubeClient)
+	// mutator object already present, no need to do anything
+	if err == nil {
+		return nil
+	}
+
+	// error other than 'not found', return err
+	if !k8serror.IsNotFound(err) {
+		return errors.Wrapf(
+			err,
+			"failed to get mutating WebhookConfiguration for {%v}",
+			mutatorWebhook,
+		)
+	}
+
+	reinvocationPolicy := admissionregistration.NeverReinvocationPolicy
+	webhookHandler := admissionregistration.MutatingWebhook{
+		Name: mutatorHandlerName,
+		Rules: []admissionregistration.RuleWithOperations{
+			{
+				Operations: []admissionregistration.OperationType{
+					admissionregistration.Create,
+					admissionregistration.Update,
+				},
+				Rule: admissionregistration.Rule{
+					APIGroups:   []string{"cstor.openebs.io"},
+					APIVersions: []string{"v1"},
+					Resources:   []string{"cstorpoolclusters", "cstorvolumeconfigs"},
+				},
+			},
+		},
+		ClientConfig: admissionregistration.WebhookClientConfig{
+			Service: &admissionregistration.ServiceReference{
+				Namespace: namespace,
+				Name:      serviceName,
+				Path:      StrPtr(mutationPath),
+			},
+			CABundle: signingCert,
+		},
+		SideEffects:             &SideEffectClassNone,
+		AdmissionReviewVersions: []string{"v1"},
+		TimeoutSeconds:          &five,
+		FailurePolicy:           failurePolicy(),
+		ReinvocationPolicy:      &reinvocationPolicy,
+	}
+
+	mutator := &admissionregistration.MutatingWebhookConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "mutatingWebhookConfiguration",
+			APIVersion: "admissionregistration.k8s.io/admissionregistration",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: mutatorWebhook,
+			Labels: map[string]string{
+				"app":                                "cstor-admission-webhook",
+				"openebs.io/component-name":          "cstor-admission-webhook",
+				string(types.OpenEBSVersionLabelKey): version.GetVersion(),
+			},
+			OwnerReferences: []metav1.OwnerReference{ownerReference},
+		},
+		Webhooks: []admissionregistration.MutatingWebhook{webhookHandler},
+	}
+
+	_, err = c.kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().
+		Create(context.TODO(), mutator, metav1.CreateOptions{})
+
+	return err
+}
+
 // createCertsSecret creates a self-signed certificate and stores it as a
 // secret resource in Kubernetes.
 func (c *client) createCertsSecret(
@@ -264,25 +381,9 @@ func (c *client) createCertsSecret(
 	namespace string,
 ) (*corev1.Secret, error) {
 
-	// Create a signing certificate
-	caKeyPair, err := NewCA(fmt.Sprintf("%s-ca", serviceName))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create root-ca: %v", err)
-	}
-
-	// Create app certs signed through the certificate created above
-	apiServerKeyPair, err := NewServerKeyPair(
-		caKeyPair,
-		strings.Join([]string{serviceName, namespace, "svc"}, "."),
-		serviceName,
-		namespace,
-		"cluster.local",
-		[]string{},
-		[]string{},
-	)
-
+	caKeyPair, apiServerKeyPair, err := newCertKeyPairs(serviceName, namespace)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create server key pair: %v", err)
+		return nil, err
 	}
 
 	// create an opaque secret resource with certificate(s) created above
@@ -321,6 +422,14 @@ func GetValidatorWebhook(
 	return kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), validator, metav1.GetOptions{})
 }
 
+// GetMutatorWebhook fetches the webhook mutator resource.
+func GetMutatorWebhook(
+	mutator string, kubeClient kubernetes.Interface,
+) (*admissionregistration.MutatingWebhookConfiguration, error) {
+
+	return kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(context.TODO(), mutator, metav1.GetOptions{})
+}
+
 // StrPtr convert a string to a pointer
 func StrPtr(s string) *string {
 	return &s
@@ -349,10 +458,11 @@ func InitValidationServer(
 		return err
 	}
 
+	source := getCertSource()
 	// Check to see if webhook secret is already present
-	certSecret, err := GetSecret(openebsNamespace, validatorSecret, c.kubeClient)
+	certSecret, err := GetSecret(openebsNamespace, source.secretName, c.kubeClient)
 	if err != nil {
-		if k8serror.IsNotFound(err) {
+		if k8serror.IsNotFound(err) && !source.external {
 			// Secret not found, create certs and the secret object
 			certSecret, err = c.createCertsSecret(
 				ownerReference,
@@ -371,18 +481,21 @@ func InitValidationServer(
 			// Unable to read secret object
 			return fmt.Errorf(
 				"unable to read secret object %s: %v",
-				validatorSecret,
+				source.secretName,
 				err,
 			)
 		}
 	}
 
-	signingCertBytes, ok := certSecret.Data[rootCrt]
-	if !ok {
+	// externally issued secret may not carry the CA, in that case caBundle
+	// is expected to be injected by the issuer (e.g. cert-manager
+	// ca-injector)
+	signingCertBytes, ok := certSecret.Data[source.caKey]
+	if !ok && !source.external {
 		return fmt.Errorf(
 			"%s value not found in %s secret",
-			rootCrt,
-			validatorSecret,
+			source.caKey,
+			source.secretName,
 		)
 	}
 
@@ -414,6 +527,29 @@ func InitValidationServer(
 		)
 	}
 
+	mutatorErr := c.createAdmissionMutatingConfig(
+		ownerReference,
+		mutatorWebhook,
+		openebsNamespace,
+		validatorServiceName,
+		signingCertBytes,
+	)
+	if mutatorErr != nil {
+		return fmt.Errorf(
+			"failed to create mutator{%s}: %v",
+			mutatorWebhook,
+			mutatorErr,
+		)
+	}
+
+	// webhook configurations created by earlier runs may trust a different
+	// CA if the secret got recreated or the certificates got rotated
+	// meanwhile
+	err = c.updateCABundle(signingCertBytes)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -444,6 +580,54 @@ func addNSWithDeleteRule(config *admissionregistration.ValidatingWebhookConfigur
 	}
 }
 
+// getBDWebhook returns the webhook validating the block devices and claims.
+// Block devices and claims are updated by NDM on every change of the device,
+// these updates are ignored if the admission server is unavailable instead
+// of blocking NDM.
+func getBDWebhook(clientConfig admissionregistration.WebhookClientConfig) admissionregistration.ValidatingWebhook {
+	return admissionregistration.ValidatingWebhook{
+		Name:                    bdWebhookHandlerName,
+		Rules:                   []admissionregistration.RuleWithOperations{bdRuleWithOperations},
+		ClientConfig:            clientConfig,
+		SideEffects:             &SideEffectClassNone,
+		AdmissionReviewVersions: []string{"v1"},
+		TimeoutSeconds:          &five,
+		FailurePolicy:           &Ignore,
+	}
+}
+
+// addBDWebhookIfMissing adds the block device webhook to the webhook
+// configuration created by older versions, the block device rule is removed
+// from the webhook of CStor resources if present
+func addBDWebhookIfMissing(config *admissionregistration.ValidatingWebhookConfiguration) {
+	rules := []admissionregistration.RuleWithOperations{}
+	for _, rule := range config.Webhooks[0].Rules {
+		if !reflect.DeepEqual(rule, bdRuleWithOperations) {
+			rules = append(rules, rule)
+		}
+	}
+	config.Webhooks[0].Rules = rules
+	for _, webhook := range config.Webhooks {
+		if webhook.Name == bdWebhookHandlerName {
+			return
+		}
+	}
+	config.Webhooks = append(config.Webhooks, getBDWebhook(config.Webhooks[0].ClientConfig))
+}
+
+// addRuleIfMissing returns the transformation which adds the given rule to
+// the webhook configuration created by older versions
+func addRuleIfMissing(newRule admissionregistration.RuleWithOperations) transformConfigFunc {
+	return func(config *admissionregistration.ValidatingWebhookConfiguration) {
+		for _, rule := range config.Webhooks[0].Rules {
+			if reflect.DeepEqual(rule, newRule) {
+				return
+			}
+		}
+		config.Webhooks[0].Rules = append(config.Webhooks[0].Rules, newRule)
+	}
+}
+
 // GetAdmissionName return the admission server name
 func GetAdmissionName() (string, error) {
 	admissionName, found := os.LookupEnv(AdmissionNameEnvVar)
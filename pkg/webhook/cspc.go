@@ -31,6 +31,7 @@ import (
 	"github.com/openebs/api/v3/pkg/apis/types"
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	"github.com/openebs/api/v3/pkg/util"
+	cspiutil "github.com/openebs/cstor-operators/pkg/controllers/cspi-controller/util"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +48,16 @@ type PoolValidator struct {
 	hostName  string
 	cspcName  string
 	clientset clientset.Interface
+	// bdPolicy is the policy to warn or deny the raid groups of block
+	// devices which are not alike
+	bdPolicy string
+	// maxCapacitySkew is the allowed capacity difference in percentage of
+	// the block devices of a raid group
+	maxCapacitySkew int
+	// existingBDs are the block devices of the CSPC before update
+	existingBDs map[string]bool
+	// warnings are the non fatal issues found during validation
+	warnings admissionWarnings
 }
 
 type getCSPC func(name, namespace string, clientset clientset.Interface) (*cstor.CStorPoolCluster, error)
@@ -111,6 +122,37 @@ func (b *Builder) withCSPCName(cspcName string) *Builder {
 	return b
 }
 
+// withBDValidationPolicy sets the block device validation policy and allowed
+// capacity skew of the raid groups from the CSPC
+func (b *Builder) withBDValidationPolicy(cspc *cstor.CStorPoolCluster) (*Builder, error) {
+	policy, err := getBDValidationPolicy(cspc)
+	if err != nil {
+		return nil, err
+	}
+	skew, err := getMaxCapacitySkew(cspc)
+	if err != nil {
+		return nil, err
+	}
+	b.object.bdPolicy = policy
+	b.object.maxCapacitySkew = skew
+	return b, nil
+}
+
+// withExistingCSPC sets the block devices of the CSPC before update
+func (b *Builder) withExistingCSPC(cspc *cstor.CStorPoolCluster) *Builder {
+	b.object.existingBDs = map[string]bool{}
+	if cspc == nil {
+		return b
+	}
+	for _, pool := range cspc.Spec.Pools {
+		rgs := append(pool.DataRaidGroups, pool.WriteCacheRaidGroups...)
+		for _, bd := range getBDsFromRaidGroups(rgs) {
+			b.object.existingBDs[bd] = true
+		}
+	}
+	return b
+}
+
 // validateCSPC validates CSPC spec for Create, Update and Delete operation of the object.
 func (wh *webhook) validateCSPC(ar *v1.AdmissionReview) *v1.AdmissionResponse {
 	req := ar.Request
@@ -140,12 +182,13 @@ func (wh *webhook) validateCSPCCreateRequest(req *v1.AdmissionRequest) *v1.Admis
 		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
 		return response
 	}
-	if ok, msg := wh.cspcValidation(&cspc); !ok {
+	ok, msg, warnings := wh.cspcValidation(&cspc, nil)
+	if !ok {
 		err := errors.Errorf("invalid cspc specification: %s", msg)
 		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusUnprocessableEntity).AR
 		return response
 	}
-	return response
+	return BuildForAPIObject(response).WithWarnings(warnings...).AR
 }
 
 // validateCSPCDeleteRequest validates CSPC delete request
@@ -181,23 +224,31 @@ func (wh *webhook) validateCSPCDeleteRequest(req *v1.AdmissionRequest) *v1.Admis
 	return response
 }
 
-func (wh *webhook) cspcValidation(cspc *cstor.CStorPoolCluster) (bool, string) {
+// cspcValidation validates the CSPC spec, oldCSPC is the existing CSPC in
+// case of update. Warnings are the issues which don't deny the request.
+func (wh *webhook) cspcValidation(cspc, oldCSPC *cstor.CStorPoolCluster) (bool, string, []string) {
 	usedNodes := map[string]bool{}
+	warnings := []string{}
 	if len(cspc.Spec.Pools) == 0 {
-		return false, fmt.Sprintf("pools in cspc should have at least one item")
+		return false, fmt.Sprintf("pools in cspc should have at least one item"), nil
 	}
 
 	repeatedBlockDevices := getDuplicateBlockDeviceList(cspc)
 	if len(repeatedBlockDevices) > 0 {
 		return false, fmt.Sprintf("invalid cspc: cspc {%s} has duplicate blockdevices entries %v",
 			cspc.Name,
-			repeatedBlockDevices)
+			repeatedBlockDevices), nil
 	}
 
-	buildPoolValidator := NewBuilder().
+	buildPoolValidator, err := NewBuilder().
 		withPoolNamespace().
 		withCSPCName(cspc.Name).
-		withClientset(wh.clientset)
+		withClientset(wh.clientset).
+		withExistingCSPC(oldCSPC).
+		withBDValidationPolicy(cspc)
+	if err != nil {
+		return false, err.Error(), nil
+	}
 	for _, pool := range cspc.Spec.Pools {
 		pool := pool // pin it
 		nodeName, err := GetHostNameFromLabelSelector(pool.NodeSelector, wh.kubeClient)
@@ -206,10 +257,10 @@ func (wh *webhook) cspcValidation(cspc *cstor.CStorPoolCluster) (bool, string) {
 				"failed to get node from pool nodeSelector: {%v} error: {%v}",
 				pool.NodeSelector,
 				err,
-			)
+			), nil
 		}
 		if usedNodes[nodeName] {
-			return false, fmt.Sprintf("invalid cspc: duplicate node %s entry", nodeName)
+			return false, fmt.Sprintf("invalid cspc: duplicate node %s entry", nodeName), nil
 		}
 		usedNodes[nodeName] = true
 		pValidate := buildPoolValidator.withPoolSpec(pool).
@@ -217,10 +268,13 @@ func (wh *webhook) cspcValidation(cspc *cstor.CStorPoolCluster) (bool, string) {
 			withPoolNodeName(nodeName).build()
 		ok, msg := pValidate.poolSpecValidation()
 		if !ok {
-			return false, fmt.Sprintf("invalid pool spec: %s", msg)
+			return false, fmt.Sprintf("invalid pool spec: %s", msg), nil
 		}
+		// validator is shared by the pools, take the warnings of this pool
+		warnings = append(warnings, pValidate.warnings...)
+		pValidate.warnings = nil
 	}
-	return true, ""
+	return true, "", warnings
 }
 
 // getDuplicateBlockDeviceList returns list of block devices that are
@@ -287,6 +341,8 @@ func (poolValidator *PoolValidator) poolSpecValidation() (bool, string) {
 		}
 	}
 
+	poolValidator.warnings = append(poolValidator.warnings,
+		getPoolSpecWarnings(poolValidator.poolSpec, poolValidator.hostName)...)
 	return true, ""
 }
 
@@ -387,14 +443,16 @@ func (poolValidator *PoolValidator) raidGroupValidation(
 		return false, msg
 	}
 
+	bdObjs := []*openebsapis.BlockDevice{}
 	for _, bd := range raidGroup.CStorPoolInstanceBlockDevices {
 		bd := bd
-		ok, msg := poolValidator.blockDeviceValidation(&bd)
+		bdObj, ok, msg := poolValidator.blockDeviceValidation(&bd)
 		if !ok {
 			return false, msg
 		}
+		bdObjs = append(bdObjs, bdObj)
 	}
-	return true, ""
+	return poolValidator.raidGroupHomogeneityValidation(bdObjs, rgType)
 }
 
 func validateBlockDevice(bd *openebsapis.BlockDevice, hostName string) error {
@@ -439,15 +497,16 @@ func validateBlockDevice(bd *openebsapis.BlockDevice, hostName string) error {
 // blockDeviceValidation validates following steps:
 // 1. block device name shouldn't be empty.
 // 2. If block device has claim it verifies whether claim is created by this CSPC
+// The validated block device object is returned.
 func (poolValidator *PoolValidator) blockDeviceValidation(
-	bd *cstor.CStorPoolInstanceBlockDevice) (bool, string) {
+	bd *cstor.CStorPoolInstanceBlockDevice) (*openebsapis.BlockDevice, bool, string) {
 	if bd.BlockDeviceName == "" {
-		return false, fmt.Sprint("block device name cannot be empty")
+		return nil, false, fmt.Sprint("block device name cannot be empty")
 	}
 	bdObj, err := poolValidator.clientset.OpenebsV1alpha1().BlockDevices(poolValidator.namespace).
 		Get(context.TODO(), bd.BlockDeviceName, metav1.GetOptions{})
 	if err != nil {
-		return false, fmt.Sprintf(
+		return nil, false, fmt.Sprintf(
 			"failed to get block device: {%s} details error: %v",
 			bd.BlockDeviceName,
 			err,
@@ -456,18 +515,18 @@ func (poolValidator *PoolValidator) blockDeviceValidation(
 	err = validateBlockDevice(bdObj, poolValidator.hostName)
 
 	if err != nil {
-		return false, fmt.Sprintf("%v", err)
+		return nil, false, fmt.Sprintf("%v", err)
 	}
 	if bdObj.Status.ClaimState == openebsapis.BlockDeviceClaimed {
 		// TODO: Need to check how NDM
 		if bdObj.Spec.ClaimRef != nil {
 			bdcName := bdObj.Spec.ClaimRef.Name
 			if err := poolValidator.blockDeviceClaimValidation(bdcName, bdObj.Name); err != nil {
-				return false, fmt.Sprintf("error: %v", err)
+				return nil, false, fmt.Sprintf("error: %v", err)
 			}
 		}
 	}
-	return true, ""
+	return bdObj, true, ""
 }
 
 func (poolValidator *PoolValidator) blockDeviceClaimValidation(bdcName, bdName string) error {
@@ -508,12 +567,17 @@ func (wh *webhook) validateCSPCUpdateRequest(req *v1.AdmissionRequest, getCSPC g
 	if reflect.DeepEqual(cspcNew.Spec, cspcOld.Spec) {
 		return response
 	}
-	if ok, msg := wh.cspcValidation(&cspcNew); !ok {
+	ok, msg, warnings := wh.cspcValidation(&cspcNew, cspcOld)
+	if !ok {
 		err = errors.Errorf("invalid cspc specification: %s", msg)
 		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusUnprocessableEntity).AR
 		return response
 	}
-	pOps := NewPoolOperations(wh.kubeClient, wh.clientset).WithNewCSPC(&cspcNew).WithOldCSPC(cspcOld)
+	response = BuildForAPIObject(response).WithWarnings(warnings...).AR
+	pOps := NewPoolOperations(wh.kubeClient, wh.clientset).
+		WithNewCSPC(&cspcNew).
+		WithOldCSPC(cspcOld).
+		WithDryRun(req.DryRun != nil && *req.DryRun)
 
 	if ok, msg := pOps.ValidateScaledown(); !ok {
 		err = errors.Errorf("invalid cspc specification: %s", msg)
@@ -590,7 +654,8 @@ func (p *PoolOperations) ValidateScaledown() (bool, string) {
 			return false, fmt.Sprintf("Could not list cvr for cspi %s: %s", cspiName, err.Error())
 		}
 		if len(cvrList.Items) != 0 {
-			return false, fmt.Sprintf("volume still exists on pool %s", cspiName)
+			return false, fmt.Sprintf("volume still exists on pool %s, annotate the pool with %s=true "+
+				"to migrate the volume replicas out of it", cspiName, cspiutil.DrainAnnotation)
 		}
 	}
 	return true, ""
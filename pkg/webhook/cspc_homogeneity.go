@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	openebsapis "github.com/openebs/api/v3/pkg/apis/openebs.io/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// BDValidationPolicyKey is the annotation on CSPC to choose whether
+	// raid groups of block devices with different drive types, sector
+	// sizes or capacities are warned about or denied
+	BDValidationPolicyKey = "cstor.openebs.io/bd-validation-policy"
+	// MaxCapacitySkewKey is the annotation on CSPC to set the allowed
+	// difference in percentage between the largest and the smallest block
+	// device of a raid group
+	MaxCapacitySkewKey = "cstor.openebs.io/max-capacity-skew-percent"
+	// BDValidationPolicyEnvVar is the env of admission server setting the
+	// policy for CSPCs without the annotation
+	BDValidationPolicyEnvVar = "ADMISSION_WEBHOOK_BD_VALIDATION_POLICY"
+
+	// BDValidationWarn reports the issue as admission warning
+	BDValidationWarn = "warn"
+	// BDValidationDeny rejects the request
+	BDValidationDeny = "deny"
+
+	// defaultMaxCapacitySkew is the allowed capacity skew in percentage
+	defaultMaxCapacitySkew = 10
+	// unknownDriveType is set by NDM when the drive type can't be detected
+	unknownDriveType = "Unknown"
+)
+
+// getBDValidationPolicy returns the block device validation policy of CSPC
+func getBDValidationPolicy(cspc *cstor.CStorPoolCluster) (string, error) {
+	if policy, ok := cspc.Annotations[BDValidationPolicyKey]; ok {
+		policy = strings.ToLower(policy)
+		if policy != BDValidationWarn && policy != BDValidationDeny {
+			return "", errors.Errorf("invalid %s annotation value %q: must be %s or %s",
+				BDValidationPolicyKey, policy, BDValidationWarn, BDValidationDeny)
+		}
+		return policy, nil
+	}
+	policy := strings.ToLower(os.Getenv(BDValidationPolicyEnvVar))
+	switch policy {
+	case BDValidationWarn, BDValidationDeny:
+		return policy, nil
+	case "":
+	default:
+		klog.Warningf("Invalid %s value %q, using %s", BDValidationPolicyEnvVar, policy, BDValidationWarn)
+	}
+	return BDValidationWarn, nil
+}
+
+// getMaxCapacitySkew returns the allowed capacity skew of the raid groups
+// of CSPC
+func getMaxCapacitySkew(cspc *cstor.CStorPoolCluster) (int, error) {
+	value, ok := cspc.Annotations[MaxCapacitySkewKey]
+	if !ok {
+		return defaultMaxCapacitySkew, nil
+	}
+	skew, err := strconv.Atoi(value)
+	if err != nil || skew < 0 || skew > 100 {
+		return 0, errors.Errorf("invalid %s annotation value %q: must be an integer from 0 to 100",
+			MaxCapacitySkewKey, value)
+	}
+	return skew, nil
+}
+
+// raidGroupHomogeneityValidation validates that the block devices of the
+// raid group have same drive type and logical sector size, and that the
+// capacities don't differ by more than the allowed skew. The issues are
+// denied only for raid groups having new block devices if the policy is
+// deny, raid groups of the existing pools are only warned about.
+func (poolValidator *PoolValidator) raidGroupHomogeneityValidation(
+	bds []*openebsapis.BlockDevice, rgType string) (bool, string) {
+	issues := getRaidGroupHomogeneityIssues(bds, rgType, poolValidator.maxCapacitySkew)
+	if len(issues) == 0 {
+		return true, ""
+	}
+	msg := strings.Join(issues, ", ")
+	if poolValidator.bdPolicy == BDValidationDeny && poolValidator.hasNewBlockDevice(bds) {
+		return false, msg
+	}
+	poolValidator.warnings.addf("pool on node %s: %s", poolValidator.hostName, msg)
+	return true, ""
+}
+
+// hasNewBlockDevice returns true if any of the block devices is not part of
+// the existing CSPC
+func (poolValidator *PoolValidator) hasNewBlockDevice(bds []*openebsapis.BlockDevice) bool {
+	for _, bd := range bds {
+		if !poolValidator.existingBDs[bd.Name] {
+			return true
+		}
+	}
+	return false
+}
+
+func getRaidGroupHomogeneityIssues(bds []*openebsapis.BlockDevice, rgType string, maxSkew int) []string {
+	issues := []string{}
+	driveTypes := map[string]bool{}
+	sectorSizes := map[uint32]bool{}
+	var smallest, largest *openebsapis.BlockDevice
+	for _, bd := range bds {
+		if bd.Spec.Details.DriveType != "" && bd.Spec.Details.DriveType != unknownDriveType {
+			driveTypes[bd.Spec.Details.DriveType] = true
+		}
+		if bd.Spec.Capacity.LogicalSectorSize != 0 {
+			sectorSizes[bd.Spec.Capacity.LogicalSectorSize] = true
+		}
+		if bd.Spec.Capacity.Storage == 0 {
+			continue
+		}
+		if smallest == nil || bd.Spec.Capacity.Storage < smallest.Spec.Capacity.Storage {
+			smallest = bd
+		}
+		if largest == nil || bd.Spec.Capacity.Storage > largest.Spec.Capacity.Storage {
+			largest = bd
+		}
+	}
+
+	if len(driveTypes) > 1 {
+		driveTypeList := []string{}
+		for driveType := range driveTypes {
+			driveTypeList = append(driveTypeList, driveType)
+		}
+		sort.Strings(driveTypeList)
+		issues = append(issues, fmt.Sprintf("raid group has block devices of different drive types %v", driveTypeList))
+	}
+	if len(sectorSizes) > 1 {
+		sizes := []int{}
+		for size := range sectorSizes {
+			sizes = append(sizes, int(size))
+		}
+		sort.Ints(sizes)
+		issues = append(issues, fmt.Sprintf("raid group has block devices of different logical sector sizes %v", sizes))
+	}
+	// stripe uses the whole capacity of all the block devices
+	if rgType != string(cstor.PoolStriped) && largest != nil {
+		skew := (largest.Spec.Capacity.Storage - smallest.Spec.Capacity.Storage) * 100 /
+			largest.Spec.Capacity.Storage
+		if skew > uint64(maxSkew) {
+			issues = append(issues, fmt.Sprintf(
+				"capacity of block devices %s and %s differs by %d%% (allowed %d%%), "+
+					"usable capacity is limited by the smaller one",
+				smallest.Name, largest.Name, skew, maxSkew))
+		}
+	}
+	return issues
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"os"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	openebsapi "github.com/openebs/api/v3/pkg/apis/openebs.io/v1alpha1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func getFakeBDWithDetails(name, driveType string, storage uint64, sectorSize uint32) *openebsapi.BlockDevice {
+	return &openebsapi.BlockDevice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openebs",
+			Labels:    map[string]string{types.HostNameLabelKey: "worker-1"},
+		},
+		Spec: openebsapi.DeviceSpec{
+			Details: openebsapi.DeviceDetails{DriveType: driveType},
+			Capacity: openebsapi.DeviceCapacity{
+				Storage:           storage,
+				LogicalSectorSize: sectorSize,
+			},
+		},
+		Status: openebsapi.DeviceStatus{State: openebsapi.BlockDeviceActive},
+	}
+}
+
+func getMirrorCSPC(annotations map[string]string, bds ...string) *cstor.CStorPoolCluster {
+	rgBDs := []cstor.CStorPoolInstanceBlockDevice{}
+	for _, bd := range bds {
+		rgBDs = append(rgBDs, *cstor.NewCStorPoolInstanceBlockDevice().WithName(bd))
+	}
+	cspc := cstor.NewCStorPoolCluster().
+		WithName("cspc-mirror").
+		WithNamespace("openebs").
+		WithPoolSpecs(
+			*cstor.NewPoolSpec().
+				WithNodeSelector(map[string]string{types.HostNameLabelKey: "worker-1"}).
+				WithPoolConfig(*cstor.NewPoolConfig().WithDataRaidGroupType("mirror")).
+				WithDataRaidGroups(*cstor.NewRaidGroup().WithCStorPoolInstanceBlockDevices(rgBDs...)),
+		)
+	cspc.Annotations = annotations
+	return cspc
+}
+
+func TestCSPCBlockDeviceHomogeneity(t *testing.T) {
+	os.Setenv("OPENEBS_NAMESPACE", "openebs")
+	const gi = uint64(1024 * 1024 * 1024)
+	bds := []runtime.Object{
+		getFakeBDWithDetails("bd-ssd-100g", "SSD", 100*gi, 512),
+		getFakeBDWithDetails("bd-ssd-105g", "SSD", 105*gi, 512),
+		getFakeBDWithDetails("bd-ssd-10t", "SSD", 10240*gi, 512),
+		getFakeBDWithDetails("bd-hdd-100g", "HDD", 100*gi, 512),
+		getFakeBDWithDetails("bd-ssd-100g-4k", "SSD", 100*gi, 4096),
+		getFakeBDWithDetails("bd-unknown-100g", "Unknown", 100*gi, 512),
+	}
+	deny := map[string]string{BDValidationPolicyKey: "deny"}
+	// pool expanded with alike block devices keeps the existing raid group
+	expandedCSPC := getMirrorCSPC(deny, "bd-ssd-100g", "bd-hdd-100g")
+	expandedCSPC.Spec.Pools[0].DataRaidGroups = append(expandedCSPC.Spec.Pools[0].DataRaidGroups,
+		*cstor.NewRaidGroup().WithCStorPoolInstanceBlockDevices(
+			*cstor.NewCStorPoolInstanceBlockDevice().WithName("bd-ssd-105g"),
+			*cstor.NewCStorPoolInstanceBlockDevice().WithName("bd-unknown-100g"),
+		))
+	tests := map[string]struct {
+		existingObj    *cstor.CStorPoolCluster
+		requestedObj   *cstor.CStorPoolCluster
+		expectedRsp    bool
+		expectWarnings int
+	}{
+		"alike block devices": {
+			requestedObj:   getMirrorCSPC(deny, "bd-ssd-100g", "bd-ssd-105g"),
+			expectedRsp:    true,
+			expectWarnings: 0,
+		},
+		"unknown drive type is not a mismatch": {
+			requestedObj:   getMirrorCSPC(deny, "bd-ssd-100g", "bd-unknown-100g"),
+			expectedRsp:    true,
+			expectWarnings: 0,
+		},
+		"capacity skew is warned by default": {
+			requestedObj:   getMirrorCSPC(nil, "bd-ssd-100g", "bd-ssd-10t"),
+			expectedRsp:    true,
+			expectWarnings: 1,
+		},
+		"capacity skew is denied": {
+			requestedObj: getMirrorCSPC(deny, "bd-ssd-100g", "bd-ssd-10t"),
+			expectedRsp:  false,
+		},
+		"capacity skew within the allowed skew": {
+			requestedObj: getMirrorCSPC(
+				map[string]string{BDValidationPolicyKey: "deny", MaxCapacitySkewKey: "100"},
+				"bd-ssd-100g", "bd-ssd-10t"),
+			expectedRsp:    true,
+			expectWarnings: 0,
+		},
+		"drive type mismatch is denied": {
+			requestedObj: getMirrorCSPC(deny, "bd-ssd-100g", "bd-hdd-100g"),
+			expectedRsp:  false,
+		},
+		"sector size mismatch is denied": {
+			requestedObj: getMirrorCSPC(deny, "bd-ssd-100g", "bd-ssd-100g-4k"),
+			expectedRsp:  false,
+		},
+		"invalid policy annotation": {
+			requestedObj: getMirrorCSPC(
+				map[string]string{BDValidationPolicyKey: "maybe"}, "bd-ssd-100g", "bd-ssd-105g"),
+			expectedRsp: false,
+		},
+		"existing raid group is only warned on update": {
+			existingObj:    getMirrorCSPC(deny, "bd-ssd-100g", "bd-hdd-100g"),
+			requestedObj:   expandedCSPC,
+			expectedRsp:    true,
+			expectWarnings: 1,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().withOpenebsObjects(bds...).withKubeObjects(getfakeNodeSpec("worker-1"))
+			req := &v1.AdmissionRequest{
+				Operation: v1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "CStorPoolCluster"},
+				Object:    runtime.RawExtension{Raw: serialize(test.requestedObj)},
+			}
+			var resp *v1.AdmissionResponse
+			if test.existingObj != nil {
+				req.Operation = v1.Update
+				getCSPC := func(name, namespace string, _ clientset.Interface) (*cstor.CStorPoolCluster, error) {
+					return test.existingObj, nil
+				}
+				resp = f.wh.validateCSPCUpdateRequest(req, getCSPC)
+			} else {
+				resp = f.wh.validateCSPCCreateRequest(req)
+			}
+			if resp.Allowed != test.expectedRsp {
+				t.Fatalf("%s test case failed expected response: %t but got %t error: %v",
+					name, test.expectedRsp, resp.Allowed, resp.Result)
+			}
+			if resp.Allowed && len(resp.Warnings) != test.expectWarnings {
+				t.Errorf("%s test case failed expected %d warnings but got %v",
+					name, test.expectWarnings, resp.Warnings)
+			}
+		})
+	}
+}
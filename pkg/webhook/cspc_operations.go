@@ -52,6 +52,10 @@ type PoolOperations struct {
 
 	// clientset is a openebs custom resource package generated for custom API group.
 	clientset clientset.Interface
+
+	// dryRun skips claiming the new blockdevices of replacements so that
+	// dry run requests e.g. previews of kubectl cstor have no side effects
+	dryRun bool
 }
 
 // NewPoolOperations returns an empty PoolOperations object.
@@ -75,6 +79,12 @@ func (pOps *PoolOperations) WithNewCSPC(newCSPC *cstor.CStorPoolCluster) *PoolOp
 	return pOps
 }
 
+// WithDryRun sets whether the CSPC modification is a dry run request
+func (pOps *PoolOperations) WithDryRun(dryRun bool) *PoolOperations {
+	pOps.dryRun = dryRun
+	return pOps
+}
+
 type poolspecs struct {
 	oldSpec []cstor.PoolSpec
 	newSpec []cstor.PoolSpec
@@ -422,7 +432,13 @@ func (pOps *PoolOperations) GetPredecessorBDIfAny(cspcOld *cstor.CStorPoolCluste
 
 // GetBDCOfBD returns the BDC object for corresponding BD.
 func (pOps *PoolOperations) GetBDCOfBD(bdName string) (*openebsapis.BlockDeviceClaim, error) {
-	bdcList, err := pOps.clientset.OpenebsV1alpha1().BlockDeviceClaims(pOps.OldCSPC.Namespace).List(context.TODO(), v1.ListOptions{})
+	return getBDCOfBD(pOps.clientset, pOps.OldCSPC.Namespace, bdName)
+}
+
+// getBDCOfBD returns the BDC object for corresponding BD in the given
+// namespace, nil is returned if the BD is not claimed.
+func getBDCOfBD(c clientset.Interface, namespace, bdName string) (*openebsapis.BlockDeviceClaim, error) {
+	bdcList, err := c.OpenebsV1alpha1().BlockDeviceClaims(namespace).List(context.TODO(), v1.ListOptions{})
 	if err != nil {
 		return nil, errors.Errorf("failed to list bdc: %s", err.Error())
 	}
@@ -781,6 +797,9 @@ func (pOps *PoolOperations) ArePoolSpecChangesValid(oldPoolSpec, newPoolSpec *cs
 		return false, fmt.Sprintf("pool expansion validation failed: %v", err)
 	}
 
+	if pOps.dryRun {
+		return true, ""
+	}
 	for newBD, oldBD := range newToOldBd {
 		err := pOps.createBDC(newBD, oldBD)
 		if err != nil {
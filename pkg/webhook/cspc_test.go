@@ -769,6 +769,7 @@ func TestBlockDeviceReplacement(t *testing.T) {
 		existingObj                      *cstor.CStorPoolCluster
 		requestedObj                     *cstor.CStorPoolCluster
 		markBlockDevicesUnderReplacement map[string]string
+		dryRun                           bool
 		expectedRsp                      bool
 		getCSPCObj                       getCSPC
 	}{
@@ -848,6 +849,41 @@ func TestBlockDeviceReplacement(t *testing.T) {
 			expectedRsp: true,
 			getCSPCObj:  getCSPCObject,
 		},
+		"Dry run of replacement on mirror pool": {
+			existingObj: cstor.NewCStorPoolCluster().
+				WithName("cspc-foo-mirror-dry-run").
+				WithNamespace("openebs").
+				WithPoolSpecs(
+					*cstor.NewPoolSpec().
+						WithNodeSelector(map[string]string{types.HostNameLabelKey: "worker-1"}).
+						WithPoolConfig(*cstor.NewPoolConfig().
+							WithDataRaidGroupType("mirror")).
+						WithDataRaidGroups(*cstor.NewRaidGroup().
+							WithCStorPoolInstanceBlockDevices(
+								*cstor.NewCStorPoolInstanceBlockDevice().WithName("blockdevice-15"),
+								*cstor.NewCStorPoolInstanceBlockDevice().WithName("blockdevice-16"),
+							),
+						),
+				),
+			requestedObj: cstor.NewCStorPoolCluster().
+				WithName("cspc-foo-mirror-dry-run").
+				WithNamespace("openebs").
+				WithPoolSpecs(
+					*cstor.NewPoolSpec().
+						WithNodeSelector(map[string]string{types.HostNameLabelKey: "worker-1"}).
+						WithPoolConfig(*cstor.NewPoolConfig().
+							WithDataRaidGroupType("mirror")).
+						WithDataRaidGroups(*cstor.NewRaidGroup().
+							WithCStorPoolInstanceBlockDevices(
+								*cstor.NewCStorPoolInstanceBlockDevice().WithName("blockdevice-17"),
+								*cstor.NewCStorPoolInstanceBlockDevice().WithName("blockdevice-16"),
+							),
+						),
+				),
+			dryRun:      true,
+			expectedRsp: true,
+			getCSPCObj:  getCSPCObject,
+		},
 		"Replacement triggered on mirror pool which has two raid groups": {
 			existingObj: cstor.NewCStorPoolCluster().
 				WithName("cspc-foo-mirror-2").
@@ -1011,6 +1047,7 @@ func TestBlockDeviceReplacement(t *testing.T) {
 				Object: runtime.RawExtension{
 					Raw: serialize(test.requestedObj),
 				},
+				DryRun: &test.dryRun,
 			}
 			// Create fake object in etcd
 			_, err := f.wh.clientset.CstorV1().
@@ -1043,6 +1080,19 @@ func TestBlockDeviceReplacement(t *testing.T) {
 					resp.Result.Message,
 				)
 			}
+			if test.dryRun {
+				// dry run must not claim the new blockdevices
+				bdcList, err := f.wh.clientset.OpenebsV1alpha1().BlockDeviceClaims("openebs").
+					List(context.TODO(), metav1.ListOptions{})
+				if err != nil {
+					t.Fatalf("failed to list blockdevice claims error: %v", err)
+				}
+				for _, bdc := range bdcList.Items {
+					if bdc.Spec.BlockDeviceName == "blockdevice-17" {
+						t.Errorf("%s test case failed blockdevice-17 is claimed by %s", name, bdc.Name)
+					}
+				}
+			}
 		})
 	}
 	// Set OPENEBS_NAMESPACE env
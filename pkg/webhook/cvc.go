@@ -39,15 +39,33 @@ func (wh *webhook) validateCVC(ar *v1.AdmissionReview) *v1.AdmissionResponse {
 	req := ar.Request
 	response := &v1.AdmissionResponse{}
 	response.Allowed = true
-	// validates only if requested operation is UPDATE
-	if req.Operation == v1.Update {
+	switch req.Operation {
+	case v1.Create:
+		return wh.validateCVCCreateRequest(req)
+	case v1.Update:
 		return wh.validateCVCUpdateRequest(req, getCVCObject)
 	}
 	klog.V(4).Info("Admission wehbook for CVC module not " +
-		"configured for operations other than UPDATE")
+		"configured for operations other than CREATE and UPDATE")
 	return response
 }
 
+// validateCVCCreateRequest allows the CVC create request, the risky specs
+// are only warned about
+func (wh *webhook) validateCVCCreateRequest(req *v1.AdmissionRequest) *v1.AdmissionResponse {
+	response := NewAdmissionResponse().
+		SetAllowed().
+		WithResultAsSuccess(http.StatusAccepted).AR
+	var cvc cstor.CStorVolumeConfig
+	err := json.Unmarshal(req.Object.Raw, &cvc)
+	if err != nil {
+		klog.Errorf("Couldn't unmarshal raw object: %+v to cvc error: %v", string(req.Object.Raw), err)
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+	return BuildForAPIObject(response).WithWarnings(getCVCCreateWarnings(&cvc)...).AR
+}
+
 func (wh *webhook) validateCVCUpdateRequest(req *v1.AdmissionRequest, getCVC getCVC) *v1.AdmissionResponse {
 	response := NewAdmissionResponse().
 		SetAllowed().
@@ -67,16 +85,19 @@ func (wh *webhook) validateCVCUpdateRequest(req *v1.AdmissionRequest, getCVC get
 		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
 		return response
 	}
-	err = validateCVCSpecChanges(cvcOldObj, &cvcNewObj)
+	err = ValidateCVCSpecChanges(cvcOldObj, &cvcNewObj)
 	if err != nil {
 		klog.Errorf("invalid cvc changes: %s error: %s", cvcOldObj.Name, err.Error())
 		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
 		return response
 	}
-	return response
+	return BuildForAPIObject(response).WithWarnings(getCVCWarnings(cvcOldObj, &cvcNewObj)...).AR
 }
 
-func validateCVCSpecChanges(cvcOldObj, cvcNewObj *cstor.CStorVolumeConfig) error {
+// ValidateCVCSpecChanges returns error if the changes from old to new CVC
+// are not allowed e.g. modified replica count or more than one replica
+// scale down at a time
+func ValidateCVCSpecChanges(cvcOldObj, cvcNewObj *cstor.CStorVolumeConfig) error {
 	validateFuncList := []validateFunc{validateReplicaCount,
 		validateProvisionedCapacity,
 		validatePoolListChanges,
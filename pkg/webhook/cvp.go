@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// minBlockSize and maxBlockSize are the limits of volume block size
+	minBlockSize = 512
+	maxBlockSize = 128 * 1024
+)
+
+var (
+	// volumeCompressionRegex matches the compressions supported by the
+	// volume replicas i.e on|off|gzip|gzip-N|lz4|lzjb|zle
+	volumeCompressionRegex = regexp.MustCompile(`^(on|off|gzip|gzip-[1-9]|lz4|lzjb|zle)$`)
+
+	supportedTolerationOperators = map[corev1.TolerationOperator]bool{
+		"":                        true,
+		corev1.TolerationOpExists: true,
+		corev1.TolerationOpEqual:  true,
+	}
+	supportedTaintEffects = map[corev1.TaintEffect]bool{
+		"":                                 true,
+		corev1.TaintEffectNoSchedule:       true,
+		corev1.TaintEffectPreferNoSchedule: true,
+		corev1.TaintEffectNoExecute:        true,
+	}
+)
+
+// validateCVP validates CStorVolumePolicy spec for Create and Update
+// operation of the object. Invalid values are rejected here as the CVC
+// controller would otherwise fallback to defaults for them.
+func (wh *webhook) validateCVP(ar *v1.AdmissionReview) *v1.AdmissionResponse {
+	req := ar.Request
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	if req.Operation != v1.Create && req.Operation != v1.Update {
+		klog.V(4).Info("Admission wehbook for CVP module not " +
+			"configured for operations other than CREATE and UPDATE")
+		return response
+	}
+
+	var cvp cstor.CStorVolumePolicy
+	err := json.Unmarshal(req.Object.Raw, &cvp)
+	if err != nil {
+		klog.Errorf("Could not unmarshal cvp %s raw object: %v, %+v", req.Name, err, string(req.Object.Raw))
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+
+	allErrs := wh.validateCVPSpec(&cvp.Spec, field.NewPath("spec"))
+	if len(allErrs) != 0 {
+		err = errors.Errorf("invalid cstorvolumepolicy %s: %v", cvp.Name, allErrs.ToAggregate())
+		klog.Error(err)
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusUnprocessableEntity).AR
+		return response
+	}
+	return response
+}
+
+// validateCVPSpec returns the errors of all the invalid fields of volume
+// policy spec
+func (wh *webhook) validateCVPSpec(spec *cstor.CStorVolumePolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateProvisionPolicy(&spec.Provision, fldPath.Child("provision"))...)
+	allErrs = append(allErrs, wh.validateTargetPolicy(&spec.Target, fldPath.Child("target"))...)
+	allErrs = append(allErrs, validateReplicaPolicy(&spec.Replica, fldPath.Child("replica"))...)
+	return allErrs
+}
+
+func validateProvisionPolicy(provision *cstor.Provision, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	blockSize := provision.BlockSize
+	if blockSize != 0 &&
+		(blockSize < minBlockSize || blockSize > maxBlockSize || blockSize&(blockSize-1) != 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("blockSize"), blockSize,
+			"must be a power of 2 from 512 to 131072"))
+	}
+	return allErrs
+}
+
+func (wh *webhook) validateTargetPolicy(target *cstor.TargetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if target.QueueDepth != "" {
+		allErrs = append(allErrs, validatePositiveInteger(target.QueueDepth, fldPath.Child("queueDepth"))...)
+	}
+	if target.IOWorkers < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("luWorkers"), target.IOWorkers,
+			"must not be negative"))
+	}
+	if target.ReplicationFactor < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicationFactor"), target.ReplicationFactor,
+			"must not be negative"))
+	}
+	allErrs = append(allErrs, metavalidation.ValidateLabels(target.NodeSelector, fldPath.Child("nodeSelector"))...)
+	allErrs = append(allErrs, validateTolerations(target.Tolerations, fldPath.Child("tolerations"))...)
+	allErrs = append(allErrs, validatePodAffinity(target.PodAffinity, fldPath.Child("affinity"))...)
+	if target.PriorityClassName != "" {
+		allErrs = append(allErrs, wh.validatePriorityClass(target.PriorityClassName, fldPath.Child("priorityClassName"))...)
+	}
+	return allErrs
+}
+
+func validateReplicaPolicy(replica *cstor.ReplicaSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if replica.IOWorkers != "" {
+		allErrs = append(allErrs, validatePositiveInteger(replica.IOWorkers, fldPath.Child("zvolWorkers"))...)
+	}
+	if replica.Compression != "" && !volumeCompressionRegex.MatchString(replica.Compression) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("compression"), replica.Compression,
+			[]string{"on", "off", "gzip", "gzip-[1-9]", "lz4", "lzjb", "zle"}))
+	}
+	return allErrs
+}
+
+// validatePositiveInteger validates the integer values which are kept as
+// string in the volume policy
+func validatePositiveInteger(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be a positive integer"))
+	}
+	return allErrs
+}
+
+// validatePriorityClass validates that the priority class exists, the target
+// pod would otherwise fail to get created
+func (wh *webhook) validatePriorityClass(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	_, err := wh.kubeClient.SchedulingV1().PriorityClasses().Get(context.TODO(), name, metav1.GetOptions{})
+	if k8serror.IsNotFound(err) {
+		allErrs = append(allErrs, field.NotFound(fldPath, name))
+	} else if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+	}
+	return allErrs
+}
+
+// validateTolerations validates the tolerations the same way as apiserver
+// validates them for pods
+func validateTolerations(tolerations []corev1.Toleration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, toleration := range tolerations {
+		idxPath := fldPath.Index(i)
+		if toleration.Key != "" {
+			for _, msg := range validation.IsQualifiedName(toleration.Key) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), toleration.Key, msg))
+			}
+		}
+		// empty key with Exists operator matches all the taints
+		if toleration.Key == "" && toleration.Operator != corev1.TolerationOpExists {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("operator"), toleration.Operator,
+				"operator must be Exists when `key` is empty, which means \"match all values and all keys\""))
+		}
+		if !supportedTolerationOperators[toleration.Operator] {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("operator"), toleration.Operator,
+				[]string{string(corev1.TolerationOpEqual), string(corev1.TolerationOpExists)}))
+		}
+		if toleration.Operator == corev1.TolerationOpExists && toleration.Value != "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("operator"), toleration,
+				"value must be empty when `operator` is 'Exists'"))
+		}
+		if toleration.Operator != corev1.TolerationOpExists {
+			for _, msg := range validation.IsValidLabelValue(toleration.Value) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), toleration.Value, msg))
+			}
+		}
+		if !supportedTaintEffects[toleration.Effect] {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), toleration.Effect,
+				[]string{
+					string(corev1.TaintEffectNoSchedule),
+					string(corev1.TaintEffectPreferNoSchedule),
+					string(corev1.TaintEffectNoExecute),
+				}))
+		}
+		if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("effect"), toleration.Effect,
+				"effect must be 'NoExecute' when `tolerationSeconds` is set"))
+		}
+	}
+	return allErrs
+}
+
+// validatePodAffinity validates the pod affinity terms of target pod
+func validatePodAffinity(podAffinity *corev1.PodAffinity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if podAffinity == nil {
+		return allErrs
+	}
+	requiredPath := fldPath.Child("requiredDuringSchedulingIgnoredDuringExecution")
+	for i, term := range podAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		allErrs = append(allErrs, validatePodAffinityTerm(term, requiredPath.Index(i))...)
+	}
+	preferredPath := fldPath.Child("preferredDuringSchedulingIgnoredDuringExecution")
+	for i, term := range podAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		idxPath := preferredPath.Index(i)
+		if term.Weight < 1 || term.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), term.Weight,
+				"must be in the range 1-100"))
+		}
+		allErrs = append(allErrs, validatePodAffinityTerm(term.PodAffinityTerm, idxPath.Child("podAffinityTerm"))...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerm(term corev1.PodAffinityTerm, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	opts := metavalidation.LabelSelectorValidationOptions{}
+	allErrs = append(allErrs, metavalidation.ValidateLabelSelector(term.LabelSelector, opts, fldPath.Child("labelSelector"))...)
+	allErrs = append(allErrs, metavalidation.ValidateLabelSelector(term.NamespaceSelector, opts, fldPath.Child("namespaceSelector"))...)
+	for _, name := range term.Namespaces {
+		for _, msg := range validation.IsDNS1123Label(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaces"), name, msg))
+		}
+	}
+	if term.TopologyKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topologyKey"), "can not be empty"))
+	} else {
+		for _, msg := range validation.IsQualifiedName(term.TopologyKey) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("topologyKey"), term.TopologyKey, msg))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidateCVP(t *testing.T) {
+	tolerationSeconds := int64(30)
+	tests := map[string]struct {
+		spec          cstor.CStorVolumePolicySpec
+		expectAllowed bool
+	}{
+		"empty policy": {
+			spec:          cstor.CStorVolumePolicySpec{},
+			expectAllowed: true,
+		},
+		"valid policy": {
+			spec: cstor.CStorVolumePolicySpec{
+				Provision: cstor.Provision{BlockSize: 8192},
+				Target: cstor.TargetSpec{
+					QueueDepth:        "32",
+					IOWorkers:         4,
+					PriorityClassName: "high",
+					NodeSelector:      map[string]string{"kubernetes.io/hostname": "node-1"},
+					Tolerations: []corev1.Toleration{
+						{
+							Key:               "node.kubernetes.io/unreachable",
+							Operator:          corev1.TolerationOpExists,
+							Effect:            corev1.TaintEffectNoExecute,
+							TolerationSeconds: &tolerationSeconds,
+						},
+					},
+					PodAffinity: &corev1.PodAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "mysql"},
+								},
+								TopologyKey: "kubernetes.io/hostname",
+							},
+						},
+					},
+				},
+				Replica: cstor.ReplicaSpec{IOWorkers: "1", Compression: "gzip-6"},
+			},
+			expectAllowed: true,
+		},
+		"invalid queue depth": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{QueueDepth: "thirty"},
+			},
+		},
+		"zero queue depth": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{QueueDepth: "0"},
+			},
+		},
+		"negative lu workers": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{IOWorkers: -1},
+			},
+		},
+		"invalid zvol workers": {
+			spec: cstor.CStorVolumePolicySpec{
+				Replica: cstor.ReplicaSpec{IOWorkers: "-2"},
+			},
+		},
+		"unsupported compression": {
+			spec: cstor.CStorVolumePolicySpec{
+				Replica: cstor.ReplicaSpec{Compression: "zstd"},
+			},
+		},
+		"invalid block size": {
+			spec: cstor.CStorVolumePolicySpec{
+				Provision: cstor.Provision{BlockSize: 3000},
+			},
+		},
+		"unknown priority class": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{PriorityClassName: "low"},
+			},
+		},
+		"toleration with value for exists operator": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{
+					Tolerations: []corev1.Toleration{
+						{Key: "key", Operator: corev1.TolerationOpExists, Value: "value"},
+					},
+				},
+			},
+		},
+		"toleration seconds without NoExecute": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{
+					Tolerations: []corev1.Toleration{
+						{
+							Key:               "key",
+							Operator:          corev1.TolerationOpEqual,
+							Effect:            corev1.TaintEffectNoSchedule,
+							TolerationSeconds: &tolerationSeconds,
+						},
+					},
+				},
+			},
+		},
+		"affinity without topology key": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{
+					PodAffinity: &corev1.PodAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight: 10,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "mysql"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"affinity with invalid selector": {
+			spec: cstor.CStorVolumePolicySpec{
+				Target: cstor.TargetSpec{
+					PodAffinity: &corev1.PodAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{Key: "app", Operator: metav1.LabelSelectorOpIn},
+									},
+								},
+								TopologyKey: "kubernetes.io/hostname",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().withKubeObjects(&schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "high"},
+				Value:      1000,
+			})
+			cvp := &cstor.CStorVolumePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "openebs"},
+				Spec:       test.spec,
+			}
+			ar := &v1.AdmissionReview{
+				Request: &v1.AdmissionRequest{
+					Operation: v1.Create,
+					Kind:      metav1.GroupVersionKind{Kind: "CStorVolumePolicy"},
+					Object:    runtime.RawExtension{Raw: serialize(cvp)},
+				},
+			}
+			resp := f.wh.validate(ar)
+			if resp.Allowed != test.expectAllowed {
+				t.Errorf("test %s failed: expected allowed %t but got %t: %v",
+					name, test.expectAllowed, resp.Allowed, resp.Result.Message)
+			}
+		})
+	}
+}
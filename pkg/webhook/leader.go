@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// leaderElectionLockName is the Lease held by the webhook replica which
+	// manages the webhook configuration and certificates
+	leaderElectionLockName = "cstor-admission-webhook-leader"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// ConfigManager bootstraps and keeps up to date the cluster configuration of
+// the webhook i.e service, certificate secret and webhook configurations.
+// Every webhook replica serves the admission requests using the shared
+// certificate secret, but only the replica holding the Lease manages the
+// configuration so that replicas don't race on creating or rotating it.
+type ConfigManager struct {
+	kubeClient     kubernetes.Interface
+	namespace      string
+	identity       string
+	ownerReference metav1.OwnerReference
+
+	// leading is true while this replica holds the Lease and has bootstrapped
+	// the configuration
+	leading atomic.Bool
+}
+
+// NewConfigManager returns a ConfigManager for this webhook replica
+func NewConfigManager(ownerReference metav1.OwnerReference,
+	kubeClient kubernetes.Interface) (*ConfigManager, error) {
+	namespace, err := getOpenebsNamespace()
+	if err != nil {
+		return nil, err
+	}
+	identity := os.Getenv("POD_NAME")
+	if identity == "" {
+		identity, err = os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ConfigManager{
+		kubeClient:     kubeClient,
+		namespace:      namespace,
+		identity:       identity,
+		ownerReference: ownerReference,
+	}, nil
+}
+
+// IsLeader returns true if this replica manages the webhook configuration
+func (m *ConfigManager) IsLeader() bool {
+	return m.leading.Load()
+}
+
+// Run keeps participating in the leader election until stopCh is closed. On
+// acquiring the Lease the webhook configuration is bootstrapped, losing the
+// Lease leaves the configuration to the new leader and the replica campaigns
+// again for the Lease.
+func (m *ConfigManager) Run(stopCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      leaderElectionLockName,
+			Namespace: m.namespace,
+		},
+		Client: m.kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: m.identity,
+		},
+	}
+
+	for {
+		runCtx, runCancel := context.WithCancel(ctx)
+		leaderelection.RunOrDie(runCtx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			ReleaseOnCancel: true,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) {
+					klog.Infof("Acquired lease %s, managing webhook configuration", leaderElectionLockName)
+					err := InitValidationServer(m.ownerReference, m.kubeClient)
+					if err != nil {
+						// release the Lease so that this or another replica
+						// retries the bootstrap
+						klog.Errorf("failed to initialize validation server: %v", err)
+						runCancel()
+						return
+					}
+					m.leading.Store(true)
+				},
+				OnStoppedLeading: func() {
+					m.leading.Store(false)
+					klog.Infof("Lost lease %s, stopped managing webhook configuration", leaderElectionLockName)
+				},
+				OnNewLeader: func(identity string) {
+					klog.Infof("Webhook replica %s manages the webhook configuration", identity)
+				},
+			},
+		})
+		runCancel()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryPeriod):
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestConfigManagerBootstrap(t *testing.T) {
+	os.Setenv("OPENEBS_NAMESPACE", testNamespace)
+	kubeClient := fake.NewSimpleClientset()
+	managers := []*ConfigManager{}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	for _, identity := range []string{"replica-1", "replica-2"} {
+		os.Setenv("POD_NAME", identity)
+		m, err := NewConfigManager(metav1.OwnerReference{}, kubeClient)
+		if err != nil {
+			t.Fatalf("failed to create config manager: %v", err)
+		}
+		managers = append(managers, m)
+		go m.Run(stopCh)
+	}
+	os.Unsetenv("POD_NAME")
+
+	// replicas wait for the secret bootstrapped by the leader
+	cw, err := newCertWatcher(kubeClient, testNamespace)
+	if err != nil {
+		t.Fatalf("failed to create cert watcher: %v", err)
+	}
+	if cert, _ := cw.GetCertificate(nil); cert == nil {
+		t.Fatalf("certificate not loaded")
+	}
+
+	err = wait.PollImmediate(100*time.Millisecond, 10*time.Second, func() (bool, error) {
+		return managers[0].IsLeader() || managers[1].IsLeader(), nil
+	})
+	if err != nil {
+		t.Fatalf("none of the replicas manages the webhook configuration")
+	}
+	if managers[0].IsLeader() && managers[1].IsLeader() {
+		t.Fatalf("both the replicas manage the webhook configuration")
+	}
+	if _, err := GetValidatorWebhook(validatorWebhook, kubeClient); err != nil {
+		t.Fatalf("validating webhook configuration not created: %v", err)
+	}
+	if _, err := kubeClient.CoreV1().Services(testNamespace).
+		Get(context.TODO(), validatorServiceName, metav1.GetOptions{}); err != nil {
+		t.Fatalf("webhook service not created: %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/cstor-operators/pkg/util/defaults"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// patchOperation is an operation of JSON patch
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// mutate sets the defaults on the different openebs resources so that the
+// stored objects carry the spec that will be reconciled
+func (wh *webhook) mutate(ar *v1.AdmissionReview) *v1.AdmissionResponse {
+	req := ar.Request
+	response := &v1.AdmissionResponse{}
+	response.Allowed = true
+	klog.Info("Admission webhook mutation request received")
+	switch req.Kind.Kind {
+	case "CStorPoolCluster":
+		klog.V(2).Infof("Admission webhook mutation request for type %s", req.Kind.Kind)
+		return wh.mutateCSPC(req)
+	case "CStorVolumeConfig":
+		klog.V(2).Infof("Admission webhook mutation request for type %s", req.Kind.Kind)
+		return wh.mutateCVC(req)
+	default:
+		klog.V(2).Infof("Admission webhook mutation not configured for type %s", req.Kind.Kind)
+		return response
+	}
+}
+
+// mutateCSPC defaults the pool config of the CSPC pools
+func (wh *webhook) mutateCSPC(req *v1.AdmissionRequest) *v1.AdmissionResponse {
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	var cspc cstor.CStorPoolCluster
+	err := json.Unmarshal(req.Object.Raw, &cspc)
+	if err != nil {
+		klog.Errorf("Could not unmarshal cspc %s raw object: %v, %+v", req.Name, err, string(req.Object.Raw))
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+	}
+
+	defaulted := cspc.DeepCopy()
+	defaults.SetCSPCDefaults(defaulted)
+	return withSpecPatch(response, cspc.Spec, defaulted.Spec)
+}
+
+// mutateCVC fills the volume policy of CVC. On create the policy is taken
+// from the volume policy referred by the CVC, the same way the CVC controller
+// does while provisioning the volume.
+func (wh *webhook) mutateCVC(req *v1.AdmissionRequest) *v1.AdmissionResponse {
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	var cvc cstor.CStorVolumeConfig
+	err := json.Unmarshal(req.Object.Raw, &cvc)
+	if err != nil {
+		klog.Errorf("Couldn't unmarshal raw object: %+v to cvc error: %v", string(req.Object.Raw), err)
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+	}
+
+	defaulted := cvc.DeepCopy()
+	if req.Operation == v1.Create &&
+		reflect.DeepEqual(cvc.Spec.Policy, cstor.CStorVolumePolicySpec{}) {
+		policyName := cvc.Annotations[string(types.VolumePolicyKey)]
+		if policyName != "" {
+			policy, err := wh.clientset.CstorV1().CStorVolumePolicies(cvc.Namespace).
+				Get(context.TODO(), policyName, metav1.GetOptions{})
+			if err != nil {
+				// provisioning reports the missing policy, defaults can't be
+				// known without it
+				klog.Warningf("Skipping defaults of cvc %s: failed to get volume policy %s: %v",
+					cvc.Name, policyName, err)
+				return response
+			}
+			defaulted.Spec.Policy = policy.Spec
+		}
+	}
+	defaults.SetVolumePolicyDefaults(&defaulted.Spec.Policy)
+	return withSpecPatch(response, cvc.Spec, defaulted.Spec)
+}
+
+// withSpecPatch sets the JSON patch on the response which replaces the spec
+// with the defaulted spec, if they differ
+func withSpecPatch(response *v1.AdmissionResponse, spec, defaulted interface{}) *v1.AdmissionResponse {
+	specBytes, err := json.Marshal(spec)
+	if err != nil {
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+	}
+	defaultedBytes, err := json.Marshal(defaulted)
+	if err != nil {
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+	}
+	if bytes.Equal(specBytes, defaultedBytes) {
+		return response
+	}
+
+	patch, err := json.Marshal([]patchOperation{
+		{
+			Op:    "add",
+			Path:  "/spec",
+			Value: defaulted,
+		},
+	})
+	if err != nil {
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+	}
+	patchType := v1.PatchTypeJSONPatch
+	response.Patch = patch
+	response.PatchType = &patchType
+	return response
+}
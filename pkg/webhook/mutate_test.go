@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	"github.com/openebs/cstor-operators/pkg/util/defaults"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// getPatchedSpec decodes the spec set by the patch of the response
+func getPatchedSpec(t *testing.T, resp *v1.AdmissionResponse, spec interface{}) bool {
+	if !resp.Allowed {
+		t.Fatalf("request not allowed: %v", resp.Result)
+	}
+	if resp.Patch == nil {
+		return false
+	}
+	var patch []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(resp.Patch, &patch); err != nil {
+		t.Fatalf("invalid patch %s: %v", resp.Patch, err)
+	}
+	if len(patch) != 1 || patch[0].Path != "/spec" {
+		t.Fatalf("unexpected patch %s", resp.Patch)
+	}
+	if err := json.Unmarshal(patch[0].Value, spec); err != nil {
+		t.Fatalf("invalid spec in patch %s: %v", resp.Patch, err)
+	}
+	return true
+}
+
+func TestMutateCSPC(t *testing.T) {
+	roThresholdLimit := 70
+	tests := map[string]struct {
+		pools             []cstor.PoolSpec
+		expectPatch       bool
+		expectROLimit     int
+		expectCompression string
+	}{
+		"pool config is defaulted": {
+			pools:             []cstor.PoolSpec{{}},
+			expectPatch:       true,
+			expectROLimit:     defaults.ROThresholdLimit,
+			expectCompression: defaults.Compression,
+		},
+		"user values are kept": {
+			pools: []cstor.PoolSpec{
+				{
+					PoolConfig: cstor.PoolConfig{
+						ROThresholdLimit: &roThresholdLimit,
+						Compression:      "off",
+					},
+				},
+			},
+			expectPatch: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cspc := &cstor.CStorPoolCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cspc", Namespace: "openebs"},
+				Spec:       cstor.CStorPoolClusterSpec{Pools: test.pools},
+			}
+			req := &v1.AdmissionRequest{
+				Operation: v1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "CStorPoolCluster"},
+				Object:    runtime.RawExtension{Raw: serialize(cspc)},
+			}
+			wh := &webhook{}
+			var spec cstor.CStorPoolClusterSpec
+			patched := getPatchedSpec(t, wh.mutate(&v1.AdmissionReview{Request: req}), &spec)
+			if patched != test.expectPatch {
+				t.Fatalf("expected patch %t but got %t", test.expectPatch, patched)
+			}
+			if !patched {
+				return
+			}
+			poolConfig := spec.Pools[0].PoolConfig
+			if *poolConfig.ROThresholdLimit != test.expectROLimit ||
+				poolConfig.Compression != test.expectCompression {
+				t.Errorf("unexpected pool config %+v", poolConfig)
+			}
+		})
+	}
+}
+
+func TestMutateCVC(t *testing.T) {
+	policy := &cstor.CStorVolumePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "openebs"},
+		Spec: cstor.CStorVolumePolicySpec{
+			Target: cstor.TargetSpec{QueueDepth: "16"},
+		},
+	}
+	tests := map[string]struct {
+		operation        v1.Operation
+		annotations      map[string]string
+		policy           cstor.CStorVolumePolicySpec
+		expectPatch      bool
+		expectQueueDepth string
+	}{
+		"create without policy gets default policy": {
+			operation:        v1.Create,
+			expectPatch:      true,
+			expectQueueDepth: defaults.QueueDepth,
+		},
+		"create with policy gets the volume policy": {
+			operation:        v1.Create,
+			annotations:      map[string]string{string(types.VolumePolicyKey): "policy"},
+			expectPatch:      true,
+			expectQueueDepth: "16",
+		},
+		"create with missing policy is left to provisioning": {
+			operation:   v1.Create,
+			annotations: map[string]string{string(types.VolumePolicyKey): "missing"},
+			expectPatch: false,
+		},
+		"update with defaulted policy is not patched": {
+			operation:   v1.Update,
+			policy:      defaults.VolumePolicySpec(),
+			expectPatch: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().withOpenebsObjects(policy)
+			cvc := &cstor.CStorVolumeConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pvc-1",
+					Namespace:   "openebs",
+					Annotations: test.annotations,
+				},
+				Spec: cstor.CStorVolumeConfigSpec{Policy: test.policy},
+			}
+			req := &v1.AdmissionRequest{
+				Operation: test.operation,
+				Kind:      metav1.GroupVersionKind{Kind: "CStorVolumeConfig"},
+				Object:    runtime.RawExtension{Raw: serialize(cvc)},
+			}
+			var spec cstor.CStorVolumeConfigSpec
+			patched := getPatchedSpec(t, f.wh.mutate(&v1.AdmissionReview{Request: req}), &spec)
+			if patched != test.expectPatch {
+				t.Fatalf("expected patch %t but got %t", test.expectPatch, patched)
+			}
+			if !patched {
+				return
+			}
+			if spec.Policy.Target.QueueDepth != test.expectQueueDepth ||
+				spec.Policy.Target.IOWorkers != defaults.IOWorkers ||
+				spec.Policy.Target.Resources == nil {
+				t.Errorf("unexpected policy %+v", spec.Policy.Target)
+			}
+		})
+	}
+}
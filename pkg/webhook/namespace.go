@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/admission/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -41,6 +42,10 @@ func (wh *webhook) validateNamespace(ar *v1.AdmissionReview) *v1.AdmissionRespon
 	if openebsNamespace == req.Name && req.Operation == v1.Delete {
 		return wh.validateNamespaceDeleteRequest(req)
 	}
+	// other namespaces are validated only if deletion protection is enabled
+	if req.Operation == v1.Delete {
+		return wh.validateNamespaceProtection(req)
+	}
 	klog.V(2).Info("Admission wehbook for Namespace module not " +
 		"configured for operations other than DELETE")
 	return response
@@ -66,5 +71,17 @@ func (wh *webhook) validateNamespaceDeleteRequest(req *v1.AdmissionRequest) *v1.
 		}
 		return response
 	}
+	// mutatingWebhookConfiguration may not exist if webhook is not yet
+	// upgraded
+	err = wh.kubeClient.AdmissionregistrationV1().
+		MutatingWebhookConfigurations().
+		Delete(context.TODO(), mutatorWebhook, metav1.DeleteOptions{})
+	if err != nil && !k8serror.IsNotFound(err) {
+		response.Allowed = false
+		response.Result = &metav1.Status{
+			Message: err.Error(),
+		}
+		return response
+	}
 	return response
 }
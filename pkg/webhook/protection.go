@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DeletionProtectionKey is the annotation on PVC or namespace which
+	// blocks deleting the PVCs while their volume has backups, restores or
+	// snapshot rebuilds in progress
+	DeletionProtectionKey = "cstor.openebs.io/deletion-protection"
+	// DeletionProtectionEnvVar is the env of admission server enabling the
+	// deletion protection for all the PVCs
+	DeletionProtectionEnvVar = "ADMISSION_WEBHOOK_DELETION_PROTECTION"
+)
+
+var (
+	// inProgressBackupStatus are the statuses of backup which are yet to
+	// complete
+	inProgressBackupStatus = map[cstor.CStorBackupStatus]bool{
+		cstor.BKPCStorStatusEmpty:      true,
+		cstor.BKPCStorStatusInit:       true,
+		cstor.BKPCStorStatusPending:    true,
+		cstor.BKPCStorStatusInProgress: true,
+	}
+	// inProgressRestoreStatus are the statuses of restore which are yet to
+	// complete
+	inProgressRestoreStatus = map[cstor.CStorRestoreStatus]bool{
+		cstor.RSTCStorStatusEmpty:      true,
+		cstor.RSTCStorStatusInit:       true,
+		cstor.RSTCStorStatusPending:    true,
+		cstor.RSTCStorStatusInProgress: true,
+	}
+)
+
+// isDeletionProtectionEnabled returns true if deletion protection is enabled
+// for all the PVCs
+func isDeletionProtectionEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(DeletionProtectionEnvVar))
+	return enabled
+}
+
+// isDeletionProtected returns true if deletion protection is enabled for
+// all the PVCs or via the annotation on any of the given objects
+func isDeletionProtected(objects ...*metav1.ObjectMeta) bool {
+	if isDeletionProtectionEnabled() {
+		return true
+	}
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		if enabled, _ := strconv.ParseBool(obj.Annotations[DeletionProtectionKey]); enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// getNamespaceMeta returns the metadata of namespace, nil is returned if the
+// namespace doesn't exist
+func (wh *webhook) getNamespaceMeta(name string) (*metav1.ObjectMeta, error) {
+	ns, err := wh.kubeClient.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		if k8serror.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get namespace %s", name)
+	}
+	return &ns.ObjectMeta, nil
+}
+
+// getDeletionBlockers returns the objects which block deleting the given
+// volumes i.e in progress backups and restores of the volumes and the
+// replicas which are yet to rebuild the snapshots of the volumes, keyed by
+// the volume name
+func (wh *webhook) getDeletionBlockers(volumeNames []string) (map[string][]string, error) {
+	blockers := map[string][]string{}
+	if len(volumeNames) == 0 {
+		return blockers, nil
+	}
+	req, err := labels.NewRequirement(pvLabelKey, selection.In, volumeNames)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build selector for volumes %v", volumeNames)
+	}
+	listOpts := metav1.ListOptions{LabelSelector: labels.NewSelector().Add(*req).String()}
+
+	backupList, err := wh.clientset.CstorV1().CStorBackups(metav1.NamespaceAll).
+		List(context.TODO(), listOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cstorbackups")
+	}
+	for _, bkp := range backupList.Items {
+		if inProgressBackupStatus[bkp.Status] {
+			volumeName := bkp.Labels[pvLabelKey]
+			blockers[volumeName] = append(blockers[volumeName],
+				fmt.Sprintf("cstorbackup %s/%s", bkp.Namespace, bkp.Name))
+		}
+	}
+
+	restoreList, err := wh.clientset.CstorV1().CStorRestores(metav1.NamespaceAll).
+		List(context.TODO(), listOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cstorrestores")
+	}
+	for _, rst := range restoreList.Items {
+		if inProgressRestoreStatus[rst.Status] {
+			volumeName := rst.Labels[pvLabelKey]
+			blockers[volumeName] = append(blockers[volumeName],
+				fmt.Sprintf("cstorrestore %s/%s", rst.Namespace, rst.Name))
+		}
+	}
+
+	cvrList, err := wh.clientset.CstorV1().CStorVolumeReplicas(metav1.NamespaceAll).
+		List(context.TODO(), listOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list replicas of volumes %v", volumeNames)
+	}
+	for _, cvr := range cvrList.Items {
+		if len(cvr.Status.PendingSnapshots) == 0 {
+			continue
+		}
+		snapshots := []string{}
+		for snapName := range cvr.Status.PendingSnapshots {
+			snapshots = append(snapshots, snapName)
+		}
+		sort.Strings(snapshots)
+		volumeName := cvr.Labels[pvLabelKey]
+		blockers[volumeName] = append(blockers[volumeName],
+			fmt.Sprintf("cstorvolumereplica %s/%s rebuilding snapshots %v", cvr.Namespace, cvr.Name, snapshots))
+	}
+	return blockers, nil
+}
+
+// validatePVCProtection denies deleting the protected PVC while its volume
+// has operations in progress
+func (wh *webhook) validatePVCProtection(pvc *corev1.PersistentVolumeClaim) *v1.AdmissionResponse {
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	if pvc.Spec.VolumeName == "" {
+		return response
+	}
+	// the namespace is looked up only if the PVC itself isn't opted in
+	if !isDeletionProtected(&pvc.ObjectMeta) {
+		nsMeta, err := wh.getNamespaceMeta(pvc.Namespace)
+		if err != nil {
+			return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+		}
+		if !isDeletionProtected(nsMeta) {
+			return response
+		}
+	}
+	volumeBlockers, err := wh.getDeletionBlockers([]string{pvc.Spec.VolumeName})
+	if err != nil {
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+	}
+	if blockers := volumeBlockers[pvc.Spec.VolumeName]; len(blockers) != 0 {
+		err = errors.Errorf("pvc %q is protected from deletion while in progress: %s",
+			pvc.Name, strings.Join(blockers, ", "))
+		klog.Error(err)
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusForbidden).AR
+	}
+	return response
+}
+
+// validateNamespaceProtection denies deleting the namespace while any of
+// its protected PVCs has operations in progress
+func (wh *webhook) validateNamespaceProtection(req *v1.AdmissionRequest) *v1.AdmissionResponse {
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	if req.Name == "" {
+		return response
+	}
+	// namespace annotation doesn't matter if protection is enabled for all
+	var nsMeta *metav1.ObjectMeta
+	if !isDeletionProtectionEnabled() {
+		var err error
+		nsMeta, err = wh.getNamespaceMeta(req.Name)
+		if err != nil {
+			return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+		}
+		if nsMeta == nil {
+			return response
+		}
+	}
+	pvcList, err := wh.kubeClient.CoreV1().PersistentVolumeClaims(req.Name).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+	}
+	protectedPVCs := map[string]string{}
+	volumeNames := []string{}
+	for _, pvc := range pvcList.Items {
+		if pvc.Spec.VolumeName == "" || !isDeletionProtected(&pvc.ObjectMeta, nsMeta) {
+			continue
+		}
+		protectedPVCs[pvc.Spec.VolumeName] = pvc.Name
+		volumeNames = append(volumeNames, pvc.Spec.VolumeName)
+	}
+	volumeBlockers, err := wh.getDeletionBlockers(volumeNames)
+	if err != nil {
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusInternalServerError).AR
+	}
+	blockers := []string{}
+	for _, volumeName := range volumeNames {
+		for _, blocker := range volumeBlockers[volumeName] {
+			blockers = append(blockers, fmt.Sprintf("pvc %s: %s", protectedPVCs[volumeName], blocker))
+		}
+	}
+	if len(blockers) != 0 {
+		err = errors.Errorf("namespace %q is protected from deletion while in progress: %s",
+			req.Name, strings.Join(blockers, ", "))
+		klog.Error(err)
+		return BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusForbidden).AR
+	}
+	return response
+}
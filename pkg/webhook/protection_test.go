@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"os"
+	"testing"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func fakeProtectedNamespace(name string, protected bool) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if protected {
+		ns.Annotations = map[string]string{DeletionProtectionKey: "true"}
+	}
+	return ns
+}
+
+func fakeProtectedPVC(name, namespace, volumeName string, protected bool) *corev1.PersistentVolumeClaim {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: volumeName},
+	}
+	if protected {
+		pvc.Annotations = map[string]string{DeletionProtectionKey: "true"}
+	}
+	return pvc
+}
+
+func fakeBackup(name, volumeName string, status cstor.CStorBackupStatus) *cstor.CStorBackup {
+	return &cstor.CStorBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openebs",
+			Labels:    map[string]string{pvLabelKey: volumeName},
+		},
+		Spec:   cstor.CStorBackupSpec{VolumeName: volumeName},
+		Status: status,
+	}
+}
+
+func fakeRestore(name, volumeName string, status cstor.CStorRestoreStatus) *cstor.CStorRestore {
+	return &cstor.CStorRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openebs",
+			Labels:    map[string]string{pvLabelKey: volumeName},
+		},
+		Spec:   cstor.CStorRestoreSpec{VolumeName: volumeName},
+		Status: status,
+	}
+}
+
+func fakeCVRWithPendingSnapshots(name, volumeName string, snapshots ...string) *cstor.CStorVolumeReplica {
+	cvr := &cstor.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openebs",
+			Labels:    map[string]string{pvLabelKey: volumeName},
+		},
+	}
+	if len(snapshots) != 0 {
+		cvr.Status.PendingSnapshots = map[string]cstor.CStorSnapshotInfo{}
+		for _, snap := range snapshots {
+			cvr.Status.PendingSnapshots[snap] = cstor.CStorSnapshotInfo{}
+		}
+	}
+	return cvr
+}
+
+func TestValidatePVCDeleteProtection(t *testing.T) {
+	tests := map[string]struct {
+		namespace      *corev1.Namespace
+		pvc            *corev1.PersistentVolumeClaim
+		openebsObjects []runtime.Object
+		expectedRsp    bool
+	}{
+		"unprotected pvc with backup in progress": {
+			namespace:      fakeProtectedNamespace("app", false),
+			pvc:            fakeProtectedPVC("pvc-1", "app", "pv-1", false),
+			openebsObjects: []runtime.Object{fakeBackup("bkp-1", "pv-1", cstor.BKPCStorStatusInProgress)},
+			expectedRsp:    true,
+		},
+		"protected pvc with backup in progress": {
+			namespace:      fakeProtectedNamespace("app", false),
+			pvc:            fakeProtectedPVC("pvc-1", "app", "pv-1", true),
+			openebsObjects: []runtime.Object{fakeBackup("bkp-1", "pv-1", cstor.BKPCStorStatusInProgress)},
+			expectedRsp:    false,
+		},
+		"protected pvc with completed backup and failed restore": {
+			namespace: fakeProtectedNamespace("app", false),
+			pvc:       fakeProtectedPVC("pvc-1", "app", "pv-1", true),
+			openebsObjects: []runtime.Object{
+				fakeBackup("bkp-1", "pv-1", cstor.BKPCStorStatusDone),
+				fakeRestore("rst-1", "pv-1", cstor.RSTCStorStatusFailed),
+			},
+			expectedRsp: true,
+		},
+		"protected pvc with backup of other volume in progress": {
+			namespace:      fakeProtectedNamespace("app", false),
+			pvc:            fakeProtectedPVC("pvc-1", "app", "pv-1", true),
+			openebsObjects: []runtime.Object{fakeBackup("bkp-1", "pv-2", cstor.BKPCStorStatusInProgress)},
+			expectedRsp:    true,
+		},
+		"pvc of protected namespace with restore pending": {
+			namespace:      fakeProtectedNamespace("app", true),
+			pvc:            fakeProtectedPVC("pvc-1", "app", "pv-1", false),
+			openebsObjects: []runtime.Object{fakeRestore("rst-1", "pv-1", cstor.RSTCStorStatusPending)},
+			expectedRsp:    false,
+		},
+		"protected pvc with snapshots pending on replica": {
+			namespace:      fakeProtectedNamespace("app", false),
+			pvc:            fakeProtectedPVC("pvc-1", "app", "pv-1", true),
+			openebsObjects: []runtime.Object{fakeCVRWithPendingSnapshots("pv-1-cstor-1", "pv-1", "snap-1")},
+			expectedRsp:    false,
+		},
+		"protected pvc with replicas rebuilt": {
+			namespace:      fakeProtectedNamespace("app", false),
+			pvc:            fakeProtectedPVC("pvc-1", "app", "pv-1", true),
+			openebsObjects: []runtime.Object{fakeCVRWithPendingSnapshots("pv-1-cstor-1", "pv-1")},
+			expectedRsp:    true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().
+				withOpenebsObjects(test.openebsObjects...).
+				withKubeObjects(test.namespace, test.pvc)
+			req := &v1.AdmissionRequest{
+				Operation: v1.Delete,
+				Kind:      metav1.GroupVersionKind{Kind: "PersistentVolumeClaim"},
+				Name:      test.pvc.Name,
+				Namespace: test.pvc.Namespace,
+			}
+			resp := f.wh.validatePVCDeleteRequest(req)
+			if resp.Allowed != test.expectedRsp {
+				t.Errorf("%s test case failed expected response: %t but got %t error: %v",
+					name, test.expectedRsp, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
+
+func TestValidateNamespaceDeleteProtection(t *testing.T) {
+	os.Setenv("OPENEBS_NAMESPACE", "openebs")
+	backup := fakeBackup("bkp-1", "pv-1", cstor.BKPCStorStatusInit)
+	tests := map[string]struct {
+		namespace   *corev1.Namespace
+		pvcs        []runtime.Object
+		expectedRsp bool
+	}{
+		"unprotected namespace": {
+			namespace:   fakeProtectedNamespace("app", false),
+			pvcs:        []runtime.Object{fakeProtectedPVC("pvc-1", "app", "pv-1", false)},
+			expectedRsp: true,
+		},
+		"protected namespace with backup in progress": {
+			namespace:   fakeProtectedNamespace("app", true),
+			pvcs:        []runtime.Object{fakeProtectedPVC("pvc-1", "app", "pv-1", false)},
+			expectedRsp: false,
+		},
+		"unprotected namespace with protected pvc": {
+			namespace:   fakeProtectedNamespace("app", false),
+			pvcs:        []runtime.Object{fakeProtectedPVC("pvc-1", "app", "pv-1", true)},
+			expectedRsp: false,
+		},
+		"protected namespace without operations in progress": {
+			namespace:   fakeProtectedNamespace("app", true),
+			pvcs:        []runtime.Object{fakeProtectedPVC("pvc-2", "app", "pv-2", false)},
+			expectedRsp: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().
+				withOpenebsObjects(backup).
+				withKubeObjects(append(test.pvcs, test.namespace)...)
+			req := &v1.AdmissionRequest{
+				Operation: v1.Delete,
+				Kind:      metav1.GroupVersionKind{Kind: "Namespace"},
+				Name:      test.namespace.Name,
+			}
+			resp := f.wh.validateNamespace(&v1.AdmissionReview{Request: req})
+			if resp.Allowed != test.expectedRsp {
+				t.Errorf("%s test case failed expected response: %t but got %t error: %v",
+					name, test.expectedRsp, resp.Allowed, resp.Result)
+			}
+		})
+	}
+}
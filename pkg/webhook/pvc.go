@@ -16,11 +16,13 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
 	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -129,7 +131,7 @@ func (wh *webhook) validatePVCDeleteRequest(req *v1.AdmissionRequest) *v1.Admiss
 		}
 		return response
 	}
-	return response
+	return wh.validatePVCProtection(pvc)
 }
 
 // getCstorVolumes gets the list of CstorVolumes based in the source-volume labels
@@ -145,97 +147,26 @@ func (wh *webhook) getCstorVolumeClaims(listOptions metav1.ListOptions) (*cstor.
 // validatePVCCreateRequest validates persistentvolumeclaim(PVC) create request
 func (wh *webhook) validatePVCCreateRequest(req *v1.AdmissionRequest) *v1.AdmissionResponse {
 	klog.Infof("Recieved PVC Create Request")
-	response := &v1.AdmissionResponse{}
-	response.Allowed = true
-	// 	var pvc corev1.PersistentVolumeClaim
-	// 	err := json.Unmarshal(req.Object.Raw, &pvc)
-	// 	if err != nil {
-	// 		klog.Errorf("Could not unmarshal raw object: %v, %v", err, req.Object.Raw)
-	// 		response.Allowed = false
-	// 		response.Result = &metav1.Status{
-	// 			Status:  metav1.StatusFailure,
-	// 			Code:    http.StatusBadRequest,
-	// 			Reason:  metav1.StatusReasonBadRequest,
-	// 			Message: err.Error(),
-	// 		}
-	// 		return response
-	// 	}
-
-	// 	// If snapshot.alpha.kubernetes.io/snapshot annotation represents the clone pvc
-	// 	// create request
-	// 	snapname := pvc.Annotations[snapshotAnnotation]
-	// 	if len(snapname) == 0 {
-	// 		return response
-	// 	}
-
-	// 	klog.V(4).Infof("AdmissionReview for creating a clone volume Kind=%v, Namespace=%v Name=%v UID=%v patchOperation=%v UserInfo=%v",
-	// 		req.Kind, req.Namespace, req.Name, req.UID, req.Operation, req.UserInfo)
-	// 	// get the snapshot object to get snapshotdata object
-	// 	// Note: If snapname is empty then below call will retrun error
-	// 	snapObj, err := wh.snapClientSet.OpenebsV1alpha1().VolumeSnapshots(pvc.Namespace).Get(snapname, metav1.GetOptions{})
-	// 	if err != nil {
-	// 		klog.Errorf("failed to get the snapshot object for snapshot name: '%s' namespace: '%s' PVC: '%s'"+
-	// 			"error: '%v'", snapname, pvc.Namespace, pvc.Name, err)
-	// 		response.Allowed = false
-	// 		response.Result = &metav1.Status{
-	// 			Message: fmt.Sprintf("Failed to get the snapshot object for snapshot name: '%s' namespace: '%s' "+
-	// 				"error: '%v'", snapname, pvc.Namespace, err.Error()),
-	// 		}
-	// 		return response
-	// 	}
-
-	// 	snapDataName := snapObj.Spec.SnapshotDataName
-	// 	if len(snapDataName) == 0 {
-	// 		klog.Errorf("Snapshotdata name is empty for snapshot: '%s' snapshot Namespace: '%s' PVC: '%s'",
-	// 			snapname, snapObj.ObjectMeta.Namespace, pvc.Name)
-	// 		response.Allowed = false
-	// 		response.Result = &metav1.Status{
-	// 			Message: fmt.Sprintf("Snapshotdata name is empty for snapshot: '%s' snapshot Namespace: '%s'",
-	// 				snapname, snapObj.ObjectMeta.Namespace),
-	// 		}
-	// 		return response
-	// 	}
-	// 	klog.V(4).Infof("snapshotdata name: '%s'", snapDataName)
-
-	// 	// get the snapDataObj to get the snapshotdataname
-	// 	// Note: If snapDataName is empty then below call will return error
-	// 	snapDataObj, err := wh.snapClientSet.OpenebsV1alpha1().VolumeSnapshotDatas().Get(snapDataName, metav1.GetOptions{})
-	// 	if err != nil {
-	// 		klog.Errorf("Failed to get the snapshotdata object for snapshotdata  name: '%s' "+
-	// 			"snapName: '%s' namespace: '%s' PVC: '%s' error: '%v'", snapDataName, snapname, snapObj.ObjectMeta.Namespace, pvc.Name, err)
-	// 		response.Allowed = false
-	// 		response.Result = &metav1.Status{
-	// 			Message: fmt.Sprintf("Failed to get the snapshotdata object for snapshotdata  name: '%s' "+
-	// 				"snapName: '%s' namespace: '%s' // snapClientSet is a snaphot custom resource package generated from custom API group.
-	// 				snapClientSet snapclient.Interfaceerror: '%v'", snapDataName, snapname, snapObj.ObjectMeta.Namespace, err.Error()),
-	// 		}
-	// 		return response
-	// 	}
-
-	// 	snapSizeString := snapDataObj.Spec.OpenEBSSnapshot.Capacity
-	// 	// If snapshotdata object doesn't consist Capacity field then we will log it and return false.
-	// 	if len(snapSizeString) == 0 {
-	// 		klog.Infof("snapshot size not found for snapshot name: '%s' snapshot namespace: '%s' snapshotdata name: '%s'",
-	// 			snapname, snapObj.ObjectMeta.Namespace, snapDataName)
-	// 		response.Allowed = false
-	// 		response.Result = &metav1.Status{
-	// 			Message: fmt.Sprintf("PVC: '%s' creation requires upgrade of volumesnapshotdata name: '%s'", pvc.ObjectMeta.Name, snapDataName),
-	// 		}
-	// 		return response
-	// 	}
-
-	// 	snapCapacity := resource.MustParse(snapSizeString)
-	// 	pvcSize := pvc.Spec.Resources.Requests[corev1.ResourceName(corev1.ResourceStorage)]
-	// 	if pvcSize.Cmp(snapCapacity) != 0 {
-	// 		klog.Errorf("Requested pvc size not matched the snapshot size '%s' belongs to snapshot name: '%s' "+
-	// 			"snapshot Namespace: '%s' VolumeSnapshotData '%s'", snapSizeString, snapObj.ObjectMeta.Name, snapObj.ObjectMeta.Namespace, snapDataName)
-	// 		response.Allowed = false
-	// 		response.Result = &metav1.Status{
-	// 			Message: fmt.Sprintf("Requested pvc size must be equal to snapshot size '%s' "+
-	// 				"which belongs to snapshot name: '%s' snapshot NameSpace: '%s' volumesnapshotdata: '%s'",
-	// 				snapSizeString, snapObj.ObjectMeta.Name, snapObj.ObjectMeta.Namespace, snapDataName),
-	// 		}
-	// 		return response
-	// 	}
+	response := NewAdmissionResponse().SetAllowed().WithResultAsSuccess(http.StatusAccepted).AR
+	var pvc corev1.PersistentVolumeClaim
+	err := json.Unmarshal(req.Object.Raw, &pvc)
+	if err != nil {
+		klog.Errorf("Could not unmarshal raw object: %v, %v", err, string(req.Object.Raw))
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusBadRequest).AR
+		return response
+	}
+
+	if !validationRequired(ignoredNamespaces, &pvc.ObjectMeta) || !isSnapshotDataSource(pvc.Spec.DataSource) {
+		return response
+	}
+
+	klog.V(4).Infof("AdmissionReview for creating a clone volume Kind=%v, Namespace=%v Name=%v UID=%v patchOperation=%v UserInfo=%v",
+		req.Kind, req.Namespace, req.Name, req.UID, req.Operation, req.UserInfo)
+	err = wh.validateCloneRequest(&pvc)
+	if err != nil {
+		klog.Errorf("invalid clone pvc %s/%s: %v", pvc.Namespace, pvc.Name, err)
+		response = BuildForAPIObject(response).UnSetAllowed().WithResultAsFailure(err, http.StatusUnprocessableEntity).AR
+		return response
+	}
 	return response
 }
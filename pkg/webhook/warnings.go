@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// roThresholdWarnLimit is the RO threshold limit from which the pool is
+	// warned to not leave enough room for the pool to recover
+	roThresholdWarnLimit = 95
+	// maxWarningLength is the length up to which the warnings are returned
+	// intact by the apiserver
+	maxWarningLength = 256
+)
+
+// admissionWarnings collects the non fatal issues of the requested object,
+// these are returned to the client as admission warnings and shown by
+// kubectl without failing the request
+type admissionWarnings []string
+
+// addf adds the formatted warning, warnings longer than maxWarningLength
+// are truncated on a rune boundary and logged in full
+func (w *admissionWarnings) addf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if len(msg) > maxWarningLength {
+		klog.Warningf("Truncating admission warning: %s", msg)
+		cut := maxWarningLength - 3
+		for cut > 0 && !utf8.RuneStart(msg[cut]) {
+			cut--
+		}
+		msg = msg[:cut] + "..."
+	}
+	*w = append(*w, msg)
+}
+
+// getPoolSpecWarnings returns the warnings of the pool spec
+func getPoolSpecWarnings(poolSpec *cstor.PoolSpec, hostName string) admissionWarnings {
+	warnings := admissionWarnings{}
+	roThresholdLimit := poolSpec.PoolConfig.ROThresholdLimit
+	if roThresholdLimit != nil && *roThresholdLimit >= roThresholdWarnLimit {
+		warnings.addf("pool on node %s: roThresholdLimit %d%% leaves little space for the pool to "+
+			"recover before it turns read only", hostName, *roThresholdLimit)
+	}
+	if poolSpec.PoolConfig.DataRaidGroupType == string(cstor.PoolStriped) &&
+		len(getBDsFromRaidGroups(poolSpec.DataRaidGroups)) == 1 {
+		warnings.addf("pool on node %s: stripe pool of single block device has no redundancy, "+
+			"data is lost if the block device fails", hostName)
+	}
+	return warnings
+}
+
+// getCVCCreateWarnings returns the warnings of the created CVC spec
+func getCVCCreateWarnings(cvc *cstor.CStorVolumeConfig) admissionWarnings {
+	warnings := admissionWarnings{}
+	if cvc.Spec.Provision.ReplicaCount == 1 {
+		warnings.addf("cvc %s has single replica, volume has no redundancy and "+
+			"becomes unavailable when the pool of the replica is down", cvc.Name)
+	}
+	return warnings
+}
+
+// getCVCWarnings returns the warnings of the updated CVC spec
+func getCVCWarnings(cvcOldObj, cvcNewObj *cstor.CStorVolumeConfig) admissionWarnings {
+	warnings := admissionWarnings{}
+	oldReplicas := len(cvcOldObj.Spec.Policy.ReplicaPoolInfo)
+	newReplicas := len(cvcNewObj.Spec.Policy.ReplicaPoolInfo)
+	// pool info is filled while provisioning, only scale down is warned
+	if newReplicas == 1 && oldReplicas > 1 {
+		warnings.addf("cvc %s is scaled down to single replica, volume has no redundancy and "+
+			"becomes unavailable when the pool of the replica is down", cvcNewObj.Name)
+	}
+	return warnings
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	cstor "github.com/openebs/api/v3/pkg/apis/cstor/v1"
+	"github.com/openebs/api/v3/pkg/apis/types"
+	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCSPCWarnings(t *testing.T) {
+	os.Setenv("OPENEBS_NAMESPACE", "openebs")
+	roThresholdLimit := 98
+	tests := map[string]struct {
+		rgType           string
+		bds              []string
+		roThresholdLimit *int
+		expectWarnings   int
+	}{
+		"stripe pool of multiple block devices": {
+			rgType:         "stripe",
+			bds:            []string{"bd-ssd-100g", "bd-ssd-105g"},
+			expectWarnings: 0,
+		},
+		"stripe pool of single block device": {
+			rgType:         "stripe",
+			bds:            []string{"bd-ssd-100g"},
+			expectWarnings: 1,
+		},
+		"mirror pool with high ro threshold limit": {
+			rgType:           "mirror",
+			bds:              []string{"bd-ssd-100g", "bd-ssd-105g"},
+			roThresholdLimit: &roThresholdLimit,
+			expectWarnings:   1,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			f := newFixture().
+				withOpenebsObjects(
+					getFakeBDWithDetails("bd-ssd-100g", "SSD", 100<<30, 512),
+					getFakeBDWithDetails("bd-ssd-105g", "SSD", 105<<30, 512),
+				).
+				withKubeObjects(getfakeNodeSpec("worker-1"))
+			cspc := getMirrorCSPC(nil, test.bds...)
+			cspc.Spec.Pools[0].PoolConfig.DataRaidGroupType = test.rgType
+			cspc.Spec.Pools[0].PoolConfig.ROThresholdLimit = test.roThresholdLimit
+			req := &v1.AdmissionRequest{
+				Operation: v1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "CStorPoolCluster"},
+				Object:    runtime.RawExtension{Raw: serialize(cspc)},
+			}
+			resp := f.wh.validateCSPCCreateRequest(req)
+			if !resp.Allowed {
+				t.Fatalf("%s test case failed: request denied: %v", name, resp.Result)
+			}
+			if len(resp.Warnings) != test.expectWarnings {
+				t.Errorf("%s test case failed expected %d warnings but got %v",
+					name, test.expectWarnings, resp.Warnings)
+			}
+		})
+	}
+}
+
+func TestCVCWarnings(t *testing.T) {
+	poolInfo := func(pools ...string) []cstor.ReplicaPoolInfo {
+		info := []cstor.ReplicaPoolInfo{}
+		for _, pool := range pools {
+			info = append(info, cstor.ReplicaPoolInfo{PoolName: pool})
+		}
+		return info
+	}
+	tests := map[string]struct {
+		oldPools       []cstor.ReplicaPoolInfo
+		newPools       []cstor.ReplicaPoolInfo
+		expectWarnings int
+	}{
+		"pool info filled for single replica volume": {
+			newPools:       poolInfo("pool-1"),
+			expectWarnings: 0,
+		},
+		"scale down to single replica": {
+			oldPools:       poolInfo("pool-1", "pool-2"),
+			newPools:       poolInfo("pool-1"),
+			expectWarnings: 1,
+		},
+		"scale down to two replicas": {
+			oldPools:       poolInfo("pool-1", "pool-2", "pool-3"),
+			newPools:       poolInfo("pool-1", "pool-2"),
+			expectWarnings: 0,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			cvc := func(pools []cstor.ReplicaPoolInfo) *cstor.CStorVolumeConfig {
+				return &cstor.CStorVolumeConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pvc-1",
+						Namespace: "openebs",
+						Labels:    map[string]string{types.CStorPoolClusterLabelKey: "cspc"},
+					},
+					Spec: cstor.CStorVolumeConfigSpec{
+						Policy: cstor.CStorVolumePolicySpec{ReplicaPoolInfo: pools},
+					},
+					Status: cstor.CStorVolumeConfigStatus{Phase: cstor.CStorVolumeConfigPhaseBound},
+				}
+			}
+			oldCVC := cvc(test.oldPools)
+			for _, info := range test.oldPools {
+				oldCVC.Status.PoolInfo = append(oldCVC.Status.PoolInfo, info.PoolName)
+			}
+			req := &v1.AdmissionRequest{
+				Operation: v1.Update,
+				Kind:      metav1.GroupVersionKind{Kind: "CStorVolumeConfig"},
+				Object:    runtime.RawExtension{Raw: serialize(cvc(test.newPools))},
+			}
+			getCVC := func(name, namespace string, _ clientset.Interface) (*cstor.CStorVolumeConfig, error) {
+				return oldCVC, nil
+			}
+			resp := newFixture().withOpenebsObjects().wh.validateCVCUpdateRequest(req, getCVC)
+			if !resp.Allowed {
+				t.Fatalf("%s test case failed: request denied: %v", name, resp.Result)
+			}
+			if len(resp.Warnings) != test.expectWarnings {
+				t.Errorf("%s test case failed expected %d warnings but got %v",
+					name, test.expectWarnings, resp.Warnings)
+			}
+		})
+	}
+}
+
+func TestCVCCreateWarnings(t *testing.T) {
+	tests := map[string]struct {
+		replicaCount   int
+		expectWarnings int
+	}{
+		"single replica volume": {
+			replicaCount:   1,
+			expectWarnings: 1,
+		},
+		"three replica volume": {
+			replicaCount:   3,
+			expectWarnings: 0,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			cvc := &cstor.CStorVolumeConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc-1", Namespace: "openebs"},
+				Spec: cstor.CStorVolumeConfigSpec{
+					Provision: cstor.VolumeProvision{ReplicaCount: test.replicaCount},
+				},
+			}
+			req := &v1.AdmissionRequest{
+				Operation: v1.Create,
+				Kind:      metav1.GroupVersionKind{Kind: "CStorVolumeConfig"},
+				Object:    runtime.RawExtension{Raw: serialize(cvc)},
+			}
+			resp := newFixture().withOpenebsObjects().wh.validateCVCCreateRequest(req)
+			if !resp.Allowed {
+				t.Fatalf("%s test case failed: request denied: %v", name, resp.Result)
+			}
+			if len(resp.Warnings) != test.expectWarnings {
+				t.Errorf("%s test case failed expected %d warnings but got %v",
+					name, test.expectWarnings, resp.Warnings)
+			}
+		})
+	}
+}
+
+func TestAddfTruncates(t *testing.T) {
+	warnings := admissionWarnings{}
+	warnings.addf("short warning")
+	warnings.addf("long warning %s", strings.Repeat("x", 2*maxWarningLength))
+	if warnings[0] != "short warning" {
+		t.Errorf("expected short warning intact but got %q", warnings[0])
+	}
+	if len(warnings[1]) != maxWarningLength || !strings.HasSuffix(warnings[1], "...") {
+		t.Errorf("expected long warning truncated to %d but got %d: %q",
+			maxWarningLength, len(warnings[1]), warnings[1])
+	}
+
+	// multi-byte runes straddling the cut aren't split
+	warnings.addf("%s", strings.Repeat("é", maxWarningLength))
+	if !utf8.ValidString(warnings[2]) || len(warnings[2]) > maxWarningLength ||
+		!strings.HasSuffix(warnings[2], "...") {
+		t.Errorf("expected long warning truncated on a rune boundary but got %q", warnings[2])
+	}
+}
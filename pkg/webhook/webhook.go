@@ -18,11 +18,12 @@ package webhook
 
 import (
 	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	clientset "github.com/openebs/api/v3/pkg/client/clientset/versioned"
 	v1 "k8s.io/api/admission/v1"
@@ -34,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -67,14 +69,20 @@ type webhook struct {
 	// clientset is a openebs custom resource package generated for custom API group.
 	clientset clientset.Interface
 
-	// snapClientSet is a snaphot custom resource package generated from custom API group.
-	// snapClientSet snapclient.Interface
+	// certs serves the TLS certificate of the server and rotates it
+	certs *certWatcher
+
+	// dynamicClient is used for the resources which don't have a typed
+	// client here e.g. CSI volume snapshots
+	dynamicClient dynamic.Interface
 }
 
 // Parameters are server configures parameters
 type Parameters struct {
 	// Port is webhook server port
 	Port int
+	// MetricsPort is the port serving the prometheus metrics of webhook
+	MetricsPort int
 	//CertFile is path to the x509 certificate for https
 	CertFile string
 	//KeyFile is path to the x509 private key matching `CertFile`
@@ -89,11 +97,12 @@ func init() {
 	_ = appsv1.AddToScheme(runtimeScheme)
 }
 
-// New creates a new instance of a webhook. Prior to
-// invoking this function, InitValidationServer function must be called to
-// set up secret (for TLS certs) k8s resource. This function runs forever.
+// New creates a new instance of a webhook. The secret (for TLS certs) k8s
+// resource is set up by InitValidationServer, either prior to invoking this
+// function or by the replica managing the webhook configuration in which
+// case this function waits for the secret.
 func New(p Parameters, kubeClient kubernetes.Interface,
-	openebsClient clientset.Interface) (
+	openebsClient clientset.Interface, dynamicClient dynamic.Interface) (
 	*webhook, error) {
 
 	admNamespace, err := getOpenebsNamespace()
@@ -101,70 +110,39 @@ func New(p Parameters, kubeClient kubernetes.Interface,
 		return nil, err
 	}
 
-	// Fetch certificate secret information
-	certSecret, err := GetSecret(admNamespace, validatorSecret, kubeClient)
-	if err != nil {
-		return nil, fmt.Errorf(
-			"failed to read secret(%s) object %v",
-			validatorSecret,
-			err,
-		)
-	}
-
-	// extract cert information from the secret object
-	certBytes, ok := certSecret.Data[appCrt]
-	if !ok {
-		return nil, fmt.Errorf(
-			"%s value not found in %s secret",
-			appCrt,
-			validatorSecret,
-		)
-	}
-	keyBytes, ok := certSecret.Data[appKey]
-	if !ok {
-		return nil, fmt.Errorf(
-			"%s value not found in %s secret",
-			appKey,
-			validatorSecret,
-		)
-	}
-
-	signingCertBytes, ok := certSecret.Data[rootCrt]
-	if !ok {
-		return nil, fmt.Errorf(
-			"%s value not found in %s secret",
-			rootCrt,
-			validatorSecret,
-		)
-	}
-
-	certPool := x509.NewCertPool()
-	ok = certPool.AppendCertsFromPEM(signingCertBytes)
-	if !ok {
-		return nil, fmt.Errorf("failed to parse root certificate")
-	}
-
-	sCert, err := tls.X509KeyPair(certBytes, keyBytes)
+	// certificates are loaded from the secret and reloaded by the watcher
+	// whenever they are renewed, without restarting the server
+	certs, err := newCertWatcher(kubeClient, admNamespace)
 	if err != nil {
 		return nil, err
 	}
 
-	//	pair, err := tls.LoadX509KeyPair(p.CertFile, p.KeyFile)
-	//	if err != nil {
-	//		return nil, err
-	//	}
 	wh := &webhook{
 		Server: &http.Server{
 			Addr:      fmt.Sprintf(":%v", p.Port),
-			TLSConfig: &tls.Config{Certificates: []tls.Certificate{sCert}},
+			TLSConfig: &tls.Config{GetCertificate: certs.GetCertificate},
 		},
-		kubeClient: kubeClient,
-		clientset:  openebsClient,
-		// snapClientSet: snapClient,
+		certs:         certs,
+		kubeClient:    kubeClient,
+		clientset:     openebsClient,
+		dynamicClient: dynamicClient,
 	}
 	return wh, nil
 }
 
+// WithConfigManager leaves the certificate rotation to the replica managing
+// the webhook configuration, the webhook only reloads the certificates
+func (wh *webhook) WithConfigManager(m *ConfigManager) *webhook {
+	wh.certs.isLeader = m.IsLeader
+	return wh
+}
+
+// RunCertWatcher keeps the serving certificate up to date until stopCh is
+// closed
+func (wh *webhook) RunCertWatcher(stopCh <-chan struct{}) {
+	wh.certs.Run(stopCh)
+}
+
 func admissionRequired(ignoredList []string, metadata *metav1.ObjectMeta) bool {
 	// skip special kubernetes system namespaces
 	for _, namespace := range ignoredList {
@@ -201,6 +179,15 @@ func (wh *webhook) validate(ar *v1.AdmissionReview) *v1.AdmissionResponse {
 	case "CStorVolumeConfig":
 		klog.V(2).Infof("Admission webhook request for type %s", req.Kind.Kind)
 		return wh.validateCVC(ar)
+	case "CStorVolumePolicy":
+		klog.V(2).Infof("Admission webhook request for type %s", req.Kind.Kind)
+		return wh.validateCVP(ar)
+	case "BlockDevice":
+		klog.V(2).Infof("Admission webhook request for type %s", req.Kind.Kind)
+		return wh.validateBD(ar)
+	case "BlockDeviceClaim":
+		klog.V(2).Infof("Admission webhook request for type %s", req.Kind.Kind)
+		return wh.validateBDC(ar)
 
 	default:
 		klog.V(2).Infof("Admission webhook not configured for type %s", req.Kind.Kind)
@@ -230,6 +217,7 @@ func (wh *webhook) Serve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	start := time.Now()
 	var admissionResponse *v1.AdmissionResponse
 	ar := v1.AdmissionReview{}
 	if _, _, err := deserializer.Decode(body, nil, &ar); err != nil {
@@ -240,10 +228,18 @@ func (wh *webhook) Serve(w http.ResponseWriter, r *http.Request) {
 			},
 		}
 	} else {
-		if r.URL.Path == "/validate" {
+		switch r.URL.Path {
+		case validationPath:
 			admissionResponse = wh.validate(&ar)
+		case mutationPath:
+			admissionResponse = wh.mutate(&ar)
 		}
 	}
+	// every decision is audited, including the requests which couldn't be
+	// decoded as those are denied too
+	if admissionResponse != nil {
+		auditAdmission(strings.TrimPrefix(r.URL.Path, "/"), ar.Request, admissionResponse, time.Since(start))
+	}
 
 	admissionReview := v1.AdmissionReview{
 		TypeMeta: metav1.TypeMeta{
@@ -23,6 +23,7 @@ import (
 	vget "github.com/openebs/cstor-operators/pkg/zcmd/zfs/get"
 	vlist "github.com/openebs/cstor-operators/pkg/zcmd/zfs/list"
 	vlistsnap "github.com/openebs/cstor-operators/pkg/zcmd/zfs/listsnap"
+	vpromote "github.com/openebs/cstor-operators/pkg/zcmd/zfs/promote"
 	vsnapshotrecv "github.com/openebs/cstor-operators/pkg/zcmd/zfs/receive"
 	vrename "github.com/openebs/cstor-operators/pkg/zcmd/zfs/rename"
 	vrollback "github.com/openebs/cstor-operators/pkg/zcmd/zfs/rollback"
@@ -155,6 +156,11 @@ func NewVolumeRollback() *vrollback.VolumeRollback {
 	return &vrollback.VolumeRollback{}
 }
 
+// NewVolumePromote returns new instance of object VolumePromote
+func NewVolumePromote() *vpromote.VolumePromote {
+	return &vpromote.VolumePromote{}
+}
+
 // NewVolumeDestroy returns new instance of object VolumeDestroy
 func NewVolumeDestroy() *vdestroy.VolumeDestroy {
 	return &vdestroy.VolumeDestroy{}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpromote
+
+import (
+	"fmt"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/openebs/cstor-operators/pkg/zcmd/bin"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Operation defines type of zfs operation
+	Operation = "promote"
+)
+
+// VolumePromote defines structure for volume 'Promote' operation
+type VolumePromote struct {
+	//clone dataset which needs to be promoted
+	Dataset string
+
+	// command string
+	Command string
+
+	// checks is list of predicate function used for validating object
+	checks []PredicateFunc
+
+	// Executor is to execute the zfs command
+	Executor bin.Executor
+
+	// error
+	err error
+}
+
+// NewVolumePromote returns new instance of object VolumePromote
+func NewVolumePromote() *VolumePromote {
+	return &VolumePromote{}
+}
+
+// WithCheck add given check to checks list
+func (v *VolumePromote) WithCheck(check ...PredicateFunc) *VolumePromote {
+	v.checks = append(v.checks, check...)
+	return v
+}
+
+// WithDataset method fills the Dataset field of VolumePromote object.
+func (v *VolumePromote) WithDataset(Dataset string) *VolumePromote {
+	v.Dataset = Dataset
+	return v
+}
+
+// WithCommand method fills the Command field of VolumePromote object.
+func (v *VolumePromote) WithCommand(Command string) *VolumePromote {
+	v.Command = Command
+	return v
+}
+
+// WithExecutor method fills the Executor field of VolumePromote object.
+func (v *VolumePromote) WithExecutor(executor bin.Executor) *VolumePromote {
+	v.Executor = executor
+	return v
+}
+
+// Validate is to validate generated VolumePromote object by builder
+func (v *VolumePromote) Validate() *VolumePromote {
+	for _, check := range v.checks {
+		if !check(v) {
+			v.err = errors.Wrapf(v.err, "validation failed {%v}", runtime.FuncForPC(reflect.ValueOf(check).Pointer()).Name())
+		}
+	}
+	return v
+}
+
+// Execute is to execute generated VolumePromote object
+func (v *VolumePromote) Execute() ([]byte, error) {
+	v, err := v.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if IsExecutorSet()(v) {
+		return v.Executor.Execute(v.Command)
+	}
+	// execute command here
+	// #nosec
+	return exec.Command(bin.BASH, "-c", v.Command).CombinedOutput()
+}
+
+// Build returns the VolumePromote object generated by builder
+func (v *VolumePromote) Build() (*VolumePromote, error) {
+	var c strings.Builder
+	v = v.Validate()
+	v.appendCommand(&c, bin.ZFS)
+	v.appendCommand(&c, fmt.Sprintf(" %s ", Operation))
+
+	v.appendCommand(&c, v.Dataset)
+
+	v.Command = c.String()
+	return v, v.err
+}
+
+// appendCommand append string to given string builder
+func (v *VolumePromote) appendCommand(c *strings.Builder, cmd string) {
+	_, err := c.WriteString(cmd)
+	if err != nil {
+		v.err = errors.Wrapf(v.err, "Failed to append cmd{%s} : %s", cmd, err.Error())
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The OpenEBS Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpromote
+
+// SetDataset method set the Dataset field of VolumePromote object.
+func (v *VolumePromote) SetDataset(Dataset string) {
+	v.Dataset = Dataset
+}
+
+// SetCommand method set the Command field of VolumePromote object.
+func (v *VolumePromote) SetCommand(Command string) {
+	v.Command = Command
+}